@@ -0,0 +1,16 @@
+data "androidpublisher_access_review" "quarterly" {
+  developer_id     = "1234567891234567891"
+  internal_domains = ["example.com"]
+
+  # The API does not report when invitations were sent, so they are dated here.
+  pending_invitation_days = 14
+
+  invitation_times = {
+    "new.hire@example.com" = "2030-01-01T00:00:00Z"
+  }
+}
+
+resource "local_file" "access_review" {
+  filename = "access-review.csv"
+  content  = data.androidpublisher_access_review.quarterly.csv
+}
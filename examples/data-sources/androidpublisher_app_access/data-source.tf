@@ -0,0 +1,4 @@
+data "androidpublisher_app_access" "test" {
+  developer_id = "1234567891234567891"
+  package_name = "com.example.app"
+}
@@ -0,0 +1,7 @@
+data "androidpublisher_permission_presets" "presets" {}
+
+resource "androidpublisher_user" "release" {
+  developer_id                  = "1234567891234567891"
+  email                         = "release-bot@myproject-123456.iam.gserviceaccount.com"
+  developer_account_permissions = data.androidpublisher_permission_presets.presets.roles["release_manager"].developer_account_permissions
+}
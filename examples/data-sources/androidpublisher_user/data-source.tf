@@ -1,3 +1,9 @@
 data "androidpublisher_user" "test" {
   developer_id = "1234567891234567891"
-}
\ No newline at end of file
+}
+
+data "androidpublisher_user" "admins" {
+  developer_id             = "1234567891234567891"
+  access_states            = ["ACCESS_GRANTED"]
+  has_developer_permission = "CAN_MANAGE_PERMISSIONS_GLOBAL"
+}
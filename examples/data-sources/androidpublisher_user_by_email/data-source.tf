@@ -0,0 +1,5 @@
+data "androidpublisher_user_by_email" "test" {
+  developer_id  = "1234567891234567891"
+  email         = "my-service@myproject-123456.iam.gserviceaccount.com"
+  allow_missing = true
+}
@@ -0,0 +1,3 @@
+output "edit_name" {
+  value = provider::androidpublisher::edit_name("com.example.app", "1716211424394")
+}
@@ -0,0 +1,3 @@
+output "grant_name" {
+  value = provider::androidpublisher::grant_name("1234567891234567891", "someone@example.com", "com.example.app")
+}
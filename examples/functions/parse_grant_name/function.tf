@@ -0,0 +1,3 @@
+output "package_name" {
+  value = provider::androidpublisher::parse_grant_name(androidpublisher_grant.example.name).package_name
+}
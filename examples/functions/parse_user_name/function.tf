@@ -0,0 +1,3 @@
+output "developer_id" {
+  value = provider::androidpublisher::parse_user_name(androidpublisher_user.example.name).developer_id
+}
@@ -0,0 +1,5 @@
+resource "androidpublisher_user" "support" {
+  developer_id                  = "1234567891234567891"
+  email                         = "support@example.com"
+  developer_account_permissions = provider::androidpublisher::role_permissions("support_agent").developer_account_permissions
+}
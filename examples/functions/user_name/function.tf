@@ -0,0 +1,3 @@
+output "user_name" {
+  value = provider::androidpublisher::user_name("1234567891234567891", "someone@example.com")
+}
@@ -0,0 +1,2 @@
+# The access policy of an app can be imported using the developer account ID and the package name.
+terraform import androidpublisher_app_access_policy.app 1234567891234567891/com.example.app
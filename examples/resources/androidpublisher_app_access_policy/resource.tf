@@ -0,0 +1,9 @@
+resource "androidpublisher_app_access_policy" "app" {
+  developer_id = "1234567891234567891"
+  package_name = "com.example.app"
+
+  app_level_permissions = {
+    "release-manager@example.com" = ["CAN_MANAGE_PUBLIC_APKS", "CAN_MANAGE_TRACK_APKS"]
+    "support@example.com"         = ["CAN_REPLY_TO_REVIEWS"]
+  }
+}
@@ -0,0 +1,2 @@
+# The users of a developer account can be imported by the developer account ID.
+terraform import androidpublisher_developer_account_users.all 1234567891234567891
@@ -0,0 +1,15 @@
+resource "androidpublisher_developer_account_users" "all" {
+  developer_id  = "1234567891234567891"
+  exempt_emails = ["owner@example.com"]
+
+  users = {
+    "release-manager@example.com" = {
+      developer_account_permissions = ["CAN_MANAGE_PUBLIC_APKS_GLOBAL", "CAN_MANAGE_TRACK_APKS_GLOBAL"]
+    }
+    "support@example.com" = {
+      grants = {
+        "com.example.app" = ["CAN_REPLY_TO_REVIEWS"]
+      }
+    }
+  }
+}
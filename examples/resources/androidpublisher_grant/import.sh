@@ -0,0 +1,2 @@
+# Grants can be imported by their resource name.
+terraform import androidpublisher_grant.test developers/1234567891234567891/users/my-service@myproject-123456.iam.gserviceaccount.com/grants/com.example.app
@@ -0,0 +1,6 @@
+resource "androidpublisher_grant" "test" {
+  email                 = "my-service@myproject-123456.iam.gserviceaccount.com"
+  developer_id          = "1234567891234567891"
+  package_name          = "com.example.app"
+  app_level_permissions = ["CAN_REPLY_TO_REVIEWS", "CAN_VIEW_APP_QUALITY"]
+}
@@ -0,0 +1,2 @@
+# Users can be imported by their resource name.
+terraform import androidpublisher_user.test developers/1234567891234567891/users/my-service@myproject-123456.iam.gserviceaccount.com
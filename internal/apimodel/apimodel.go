@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package apimodel holds Terraform models of the Android Publisher API types,
+// generated by tools/schemagen from the API discovery document. Every
+// generated type has a model struct, its attribute types, its resource and
+// data source schema attributes and FromAPI and ToAPI converters. Run "make
+// generate" after changing tools/schemagen/config.json.
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func stringFromAPI(value string, nullIfZero bool) types.String {
+	if nullIfZero && value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func int64FromAPI(value int64, nullIfZero bool) types.Int64 {
+	if nullIfZero && value == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(value)
+}
+
+func boolFromAPI(value bool, nullIfZero bool) types.Bool {
+	if nullIfZero && !value {
+		return types.BoolNull()
+	}
+	return types.BoolValue(value)
+}
+
+func float64FromAPI(value float64, nullIfZero bool) types.Float64 {
+	if nullIfZero && value == 0 {
+		return types.Float64Null()
+	}
+	return types.Float64Value(value)
+}
+
+func listFromAPI[T any](ctx context.Context, elemType attr.Type, values []T, nullIfEmpty bool) (types.List, diag.Diagnostics) {
+	if nullIfEmpty && len(values) == 0 {
+		return types.ListNull(elemType), nil
+	}
+	if values == nil {
+		values = []T{}
+	}
+	return types.ListValueFrom(ctx, elemType, values)
+}
+
+func setFromAPI[T any](ctx context.Context, elemType attr.Type, values []T, nullIfEmpty bool) (types.Set, diag.Diagnostics) {
+	if nullIfEmpty && len(values) == 0 {
+		return types.SetNull(elemType), nil
+	}
+	if values == nil {
+		values = []T{}
+	}
+	return types.SetValueFrom(ctx, elemType, values)
+}
+
+func mapFromAPI[T any](ctx context.Context, elemType attr.Type, values map[string]T, nullIfEmpty bool) (types.Map, diag.Diagnostics) {
+	if nullIfEmpty && len(values) == 0 {
+		return types.MapNull(elemType), nil
+	}
+	if values == nil {
+		values = map[string]T{}
+	}
+	return types.MapValueFrom(ctx, elemType, values)
+}
+
+// collection is a list, set or map value.
+type collection interface {
+	IsNull() bool
+	IsUnknown() bool
+	ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics
+}
+
+// elementsAs sets target to the elements of the collection, leaving it
+// empty when the collection is null or unknown.
+func elementsAs(ctx context.Context, value collection, target interface{}) diag.Diagnostics {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return value.ElementsAs(ctx, target, false)
+}
+
+// objectAs returns the model of an object, or nil when the object is null
+// or unknown.
+func objectAs[M any](ctx context.Context, value types.Object) (*M, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+	var model M
+	diags := value.As(ctx, &model, basetypes.ObjectAsOptions{})
+	return &model, diags
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimodel
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"google.golang.org/api/androidpublisher/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// apiModel is a generated model.
+type apiModel[A any] interface {
+	FromAPI(ctx context.Context, in *A) diag.Diagnostics
+}
+
+// roundTrip converts an API object to its model, stores the model in a
+// state with the generated schema and converts it back.
+func roundTrip[A any, M any, P interface {
+	*M
+	apiModel[A]
+}](t *testing.T, in *A, attributes map[string]schema.Attribute, toAPI func(M) (*A, diag.Diagnostics)) (M, *A) {
+	t.Helper()
+	ctx := context.Background()
+
+	var model M
+	if diags := P(&model).FromAPI(ctx, in); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	s := schema.Schema{Attributes: attributes}
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatal(diags)
+	}
+	var stored M
+	if diags := state.Get(ctx, &stored); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	out, diags := toAPI(stored)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	return stored, out
+}
+
+func TestAttributesAreValid(t *testing.T) {
+	ctx := context.Background()
+	tests := map[string]struct {
+		attributes map[string]schema.Attribute
+		attrTypes  map[string]attr.Type
+	}{
+		"User":           {UserAttributes(), UserAttrTypes},
+		"Grant":          {GrantAttributes(), GrantAttrTypes},
+		"AppEdit":        {AppEditAttributes(), AppEditAttrTypes},
+		"Track":          {TrackAttributes(), TrackAttrTypes},
+		"InAppProduct":   {InAppProductAttributes(), InAppProductAttrTypes},
+		"Subscription":   {SubscriptionAttributes(), SubscriptionAttrTypes},
+		"VoidedPurchase": {VoidedPurchaseAttributes(), VoidedPurchaseAttrTypes},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := schema.Schema{Attributes: tt.attributes}
+			if diags := s.ValidateImplementation(ctx); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if expected := (types.ObjectType{AttrTypes: tt.attrTypes}); !s.Type().Equal(expected) {
+				t.Errorf("expected the attributes to have type %s, got %s", expected, s.Type())
+			}
+		})
+	}
+}
+
+func TestDataSourceAttributesAreComputed(t *testing.T) {
+	ctx := context.Background()
+	tests := map[string]struct {
+		attributes map[string]dsschema.Attribute
+		attrTypes  map[string]attr.Type
+	}{
+		"User":           {UserDataSourceAttributes(), UserAttrTypes},
+		"Grant":          {GrantDataSourceAttributes(), GrantAttrTypes},
+		"VoidedPurchase": {VoidedPurchaseDataSourceAttributes(), VoidedPurchaseAttrTypes},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := dsschema.Schema{Attributes: tt.attributes}
+			if diags := s.ValidateImplementation(ctx); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if expected := (types.ObjectType{AttrTypes: tt.attrTypes}); !s.Type().Equal(expected) {
+				t.Errorf("expected the attributes to have type %s, got %s", expected, s.Type())
+			}
+			for attrName, attribute := range tt.attributes {
+				if !attribute.IsComputed() || attribute.IsRequired() || attribute.IsOptional() {
+					t.Errorf("expected %s to be computed only", attrName)
+				}
+			}
+		})
+	}
+}
+
+// TestSensitiveOverride checks that the sensitive override of
+// VoidedPurchase.purchaseToken in config.json reaches the generated schemas.
+func TestSensitiveOverride(t *testing.T) {
+	resourceAttributes := VoidedPurchaseAttributes()
+	dataSourceAttributes := VoidedPurchaseDataSourceAttributes()
+	if !resourceAttributes["purchase_token"].IsSensitive() || !dataSourceAttributes["purchase_token"].IsSensitive() {
+		t.Error("expected purchase_token to be sensitive")
+	}
+	if resourceAttributes["order_id"].IsSensitive() || dataSourceAttributes["order_id"].IsSensitive() {
+		t.Error("expected order_id not to be sensitive")
+	}
+}
+
+func TestUserRoundTrip(t *testing.T) {
+	in := &androidpublisher.User{
+		AccessState:                 "ACCESS_GRANTED",
+		DeveloperAccountPermissions: []string{"CAN_SEE_ALL_APPS"},
+		Email:                       "user@example.com",
+		ExpirationTime:              "2030-01-01T00:00:00Z",
+		Grants: []*androidpublisher.Grant{{
+			AppLevelPermissions: []string{"CAN_REPLY_TO_REVIEWS", "CAN_VIEW_APP_QUALITY"},
+			Name:                "developers/123/users/user@example.com/grants/com.example.app",
+			PackageName:         "com.example.app",
+		}},
+		Name:    "developers/123/users/user@example.com",
+		Partial: true,
+	}
+	_, out := roundTrip[androidpublisher.User, UserModel](t, in, UserAttributes(), func(m UserModel) (*androidpublisher.User, diag.Diagnostics) {
+		return m.ToAPI(context.Background())
+	})
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("expected %+v, got %+v", in, out)
+	}
+}
+
+func TestUserNullIfZero(t *testing.T) {
+	model, out := roundTrip[androidpublisher.User, UserModel](t, &androidpublisher.User{Email: "user@example.com"}, UserAttributes(), func(m UserModel) (*androidpublisher.User, diag.Diagnostics) {
+		return m.ToAPI(context.Background())
+	})
+	// Optional attributes the API omits stay null, computed ones are set.
+	if !model.ExpirationTime.IsNull() {
+		t.Errorf("expected omitted optional attributes to be null, got %+v", model)
+	}
+	if model.Name.IsNull() || model.Grants.IsNull() || model.Partial.IsNull() || model.DeveloperAccountPermissions.IsNull() {
+		t.Errorf("expected computed attributes to be set, got %+v", model)
+	}
+	if out.Email != "user@example.com" || out.ExpirationTime != "" || len(out.DeveloperAccountPermissions) != 0 {
+		t.Errorf("unexpected API object %+v", out)
+	}
+}
+
+func TestTrackRoundTrip(t *testing.T) {
+	in := &androidpublisher.Track{
+		Track: "production",
+		Releases: []*androidpublisher.TrackRelease{{
+			CountryTargeting:    &androidpublisher.CountryTargeting{Countries: []string{"DE", "FR"}, IncludeRestOfWorld: true},
+			InAppUpdatePriority: 3,
+			Name:                "1.0",
+			ReleaseNotes:        []*androidpublisher.LocalizedText{{Language: "en-US", Text: "Fixes"}},
+			Status:              "inProgress",
+			UserFraction:        0.25,
+			VersionCodes:        googleapi.Int64s{41, 42},
+		}},
+	}
+	_, out := roundTrip[androidpublisher.Track, TrackModel](t, in, TrackAttributes(), func(m TrackModel) (*androidpublisher.Track, diag.Diagnostics) {
+		return m.ToAPI(context.Background())
+	})
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("expected %+v, got %+v", in, out)
+	}
+}
+
+func TestInAppProductRoundTrip(t *testing.T) {
+	in := &androidpublisher.InAppProduct{
+		DefaultLanguage: "en-US",
+		DefaultPrice:    &androidpublisher.Price{Currency: "EUR", PriceMicros: "990000"},
+		Listings: map[string]androidpublisher.InAppProductListing{
+			"en-US": {Title: "Coins", Description: "A bag of coins"},
+		},
+		PackageName: "com.example.app",
+		Prices: map[string]androidpublisher.Price{
+			"DE": {Currency: "EUR", PriceMicros: "990000"},
+		},
+		PurchaseType: "managedUser",
+		Sku:          "coins",
+		Status:       "active",
+	}
+	_, out := roundTrip[androidpublisher.InAppProduct, InAppProductModel](t, in, InAppProductAttributes(), func(m InAppProductModel) (*androidpublisher.InAppProduct, diag.Diagnostics) {
+		return m.ToAPI(context.Background())
+	})
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("expected %+v, got %+v", in, out)
+	}
+}
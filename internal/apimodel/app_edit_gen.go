@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// AppEditModel is the Terraform model of androidpublisher.AppEdit. An app edit.
+// The resource for EditsService.
+type AppEditModel struct {
+	ExpiryTimeSeconds types.String `tfsdk:"expiry_time_seconds"`
+	Id                types.String `tfsdk:"id"`
+}
+
+// AppEditAttrTypes are the attribute types of AppEditModel.
+var AppEditAttrTypes = map[string]attr.Type{
+	"expiry_time_seconds": types.StringType,
+	"id":                  types.StringType,
+}
+
+// AppEditAttributes returns the resource schema attributes of AppEditModel.
+func AppEditAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"expiry_time_seconds": schema.StringAttribute{
+			MarkdownDescription: "Output only. The time (as seconds since Epoch) at which the edit will expire and will be no longer valid for use.",
+			Computed:            true,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Output only. Identifier of the edit. Can be used in subsequent API calls.",
+			Computed:            true,
+		},
+	}
+}
+
+// AppEditDataSourceAttributes returns the data source schema attributes of AppEditModel,
+// which are all computed.
+func AppEditDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"expiry_time_seconds": dsschema.StringAttribute{
+			MarkdownDescription: "Output only. The time (as seconds since Epoch) at which the edit will expire and will be no longer valid for use.",
+			Computed:            true,
+		},
+		"id": dsschema.StringAttribute{
+			MarkdownDescription: "Output only. Identifier of the edit. Can be used in subsequent API calls.",
+			Computed:            true,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *AppEditModel) FromAPI(ctx context.Context, in *androidpublisher.AppEdit) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.AppEdit{}
+	}
+	m.ExpiryTimeSeconds = stringFromAPI(in.ExpiryTimeSeconds, false)
+	m.Id = stringFromAPI(in.Id, false)
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m AppEditModel) ToAPI(ctx context.Context) (*androidpublisher.AppEdit, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.AppEdit{}
+	out.ExpiryTimeSeconds = m.ExpiryTimeSeconds.ValueString()
+	out.Id = m.Id.ValueString()
+	return out, diags
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// AutoRenewingBasePlanTypeModel is the Terraform model of
+// androidpublisher.AutoRenewingBasePlanType. Represents a base plan that
+// automatically renews at the end of its subscription period.
+type AutoRenewingBasePlanTypeModel struct {
+	AccountHoldDuration                 types.String `tfsdk:"account_hold_duration"`
+	BillingPeriodDuration               types.String `tfsdk:"billing_period_duration"`
+	GracePeriodDuration                 types.String `tfsdk:"grace_period_duration"`
+	LegacyCompatible                    types.Bool   `tfsdk:"legacy_compatible"`
+	LegacyCompatibleSubscriptionOfferId types.String `tfsdk:"legacy_compatible_subscription_offer_id"`
+	ProrationMode                       types.String `tfsdk:"proration_mode"`
+	ResubscribeState                    types.String `tfsdk:"resubscribe_state"`
+}
+
+// AutoRenewingBasePlanTypeAttrTypes are the attribute types of AutoRenewingBasePlanTypeModel.
+var AutoRenewingBasePlanTypeAttrTypes = map[string]attr.Type{
+	"account_hold_duration":                   types.StringType,
+	"billing_period_duration":                 types.StringType,
+	"grace_period_duration":                   types.StringType,
+	"legacy_compatible":                       types.BoolType,
+	"legacy_compatible_subscription_offer_id": types.StringType,
+	"proration_mode":                          types.StringType,
+	"resubscribe_state":                       types.StringType,
+}
+
+// AutoRenewingBasePlanTypeAttributes returns the resource schema attributes of AutoRenewingBasePlanTypeModel.
+func AutoRenewingBasePlanTypeAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"account_hold_duration": schema.StringAttribute{
+			MarkdownDescription: "Optional. Account hold period of the subscription, specified in ISO 8601 format. Acceptable values must be in DAYS and in the range P0D (zero days) to P30D (30 days). If not specified, the default value is P30D (30 days).",
+			Optional:            true,
+		},
+		"billing_period_duration": schema.StringAttribute{
+			MarkdownDescription: "Required. Immutable. Subscription period, specified in ISO 8601 format. For a list of acceptable billing periods, refer to the help center. The duration is immutable after the base plan is created.",
+			Optional:            true,
+		},
+		"grace_period_duration": schema.StringAttribute{
+			MarkdownDescription: "Grace period of the subscription, specified in ISO 8601 format. Acceptable values are P0D (zero days), P3D (3 days), P7D (7 days), P14D (14 days), and P30D (30 days). If not specified, a default value will be used based on the recurring period duration.",
+			Optional:            true,
+		},
+		"legacy_compatible": schema.BoolAttribute{
+			MarkdownDescription: "Whether the renewing base plan is backward compatible. The backward compatible base plan is returned by the Google Play Billing Library deprecated method querySkuDetailsAsync(). Only one renewing base plan can be marked as legacy compatible for a given subscription.",
+			Optional:            true,
+		},
+		"legacy_compatible_subscription_offer_id": schema.StringAttribute{
+			MarkdownDescription: "Subscription offer id which is legacy compatible. The backward compatible subscription offer is returned by the Google Play Billing Library deprecated method querySkuDetailsAsync(). Only one subscription offer can be marked as legacy compatible for a given renewing base plan. To have no Subscription offer as legacy compatible set this field as empty string.",
+			Optional:            true,
+		},
+		"proration_mode": schema.StringAttribute{
+			MarkdownDescription: "The proration mode for the base plan determines what happens when a user switches to this plan from another base plan. If unspecified, defaults to CHARGE_ON_NEXT_BILLING_DATE. Possible values are `SUBSCRIPTION_PRORATION_MODE_UNSPECIFIED`, `SUBSCRIPTION_PRORATION_MODE_CHARGE_ON_NEXT_BILLING_DATE`, `SUBSCRIPTION_PRORATION_MODE_CHARGE_FULL_PRICE_IMMEDIATELY`.",
+			Optional:            true,
+		},
+		"resubscribe_state": schema.StringAttribute{
+			MarkdownDescription: "Whether users should be able to resubscribe to this base plan in Google Play surfaces. Defaults to RESUBSCRIBE_STATE_ACTIVE if not specified. Possible values are `RESUBSCRIBE_STATE_UNSPECIFIED`, `RESUBSCRIBE_STATE_ACTIVE`, `RESUBSCRIBE_STATE_INACTIVE`.",
+			Optional:            true,
+		},
+	}
+}
+
+// AutoRenewingBasePlanTypeDataSourceAttributes returns the data source schema attributes of AutoRenewingBasePlanTypeModel,
+// which are all computed.
+func AutoRenewingBasePlanTypeDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"account_hold_duration": dsschema.StringAttribute{
+			MarkdownDescription: "Optional. Account hold period of the subscription, specified in ISO 8601 format. Acceptable values must be in DAYS and in the range P0D (zero days) to P30D (30 days). If not specified, the default value is P30D (30 days).",
+			Computed:            true,
+		},
+		"billing_period_duration": dsschema.StringAttribute{
+			MarkdownDescription: "Required. Immutable. Subscription period, specified in ISO 8601 format. For a list of acceptable billing periods, refer to the help center. The duration is immutable after the base plan is created.",
+			Computed:            true,
+		},
+		"grace_period_duration": dsschema.StringAttribute{
+			MarkdownDescription: "Grace period of the subscription, specified in ISO 8601 format. Acceptable values are P0D (zero days), P3D (3 days), P7D (7 days), P14D (14 days), and P30D (30 days). If not specified, a default value will be used based on the recurring period duration.",
+			Computed:            true,
+		},
+		"legacy_compatible": dsschema.BoolAttribute{
+			MarkdownDescription: "Whether the renewing base plan is backward compatible. The backward compatible base plan is returned by the Google Play Billing Library deprecated method querySkuDetailsAsync(). Only one renewing base plan can be marked as legacy compatible for a given subscription.",
+			Computed:            true,
+		},
+		"legacy_compatible_subscription_offer_id": dsschema.StringAttribute{
+			MarkdownDescription: "Subscription offer id which is legacy compatible. The backward compatible subscription offer is returned by the Google Play Billing Library deprecated method querySkuDetailsAsync(). Only one subscription offer can be marked as legacy compatible for a given renewing base plan. To have no Subscription offer as legacy compatible set this field as empty string.",
+			Computed:            true,
+		},
+		"proration_mode": dsschema.StringAttribute{
+			MarkdownDescription: "The proration mode for the base plan determines what happens when a user switches to this plan from another base plan. If unspecified, defaults to CHARGE_ON_NEXT_BILLING_DATE. Possible values are `SUBSCRIPTION_PRORATION_MODE_UNSPECIFIED`, `SUBSCRIPTION_PRORATION_MODE_CHARGE_ON_NEXT_BILLING_DATE`, `SUBSCRIPTION_PRORATION_MODE_CHARGE_FULL_PRICE_IMMEDIATELY`.",
+			Computed:            true,
+		},
+		"resubscribe_state": dsschema.StringAttribute{
+			MarkdownDescription: "Whether users should be able to resubscribe to this base plan in Google Play surfaces. Defaults to RESUBSCRIBE_STATE_ACTIVE if not specified. Possible values are `RESUBSCRIBE_STATE_UNSPECIFIED`, `RESUBSCRIBE_STATE_ACTIVE`, `RESUBSCRIBE_STATE_INACTIVE`.",
+			Computed:            true,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *AutoRenewingBasePlanTypeModel) FromAPI(ctx context.Context, in *androidpublisher.AutoRenewingBasePlanType) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.AutoRenewingBasePlanType{}
+	}
+	m.AccountHoldDuration = stringFromAPI(in.AccountHoldDuration, true)
+	m.BillingPeriodDuration = stringFromAPI(in.BillingPeriodDuration, true)
+	m.GracePeriodDuration = stringFromAPI(in.GracePeriodDuration, true)
+	m.LegacyCompatible = boolFromAPI(in.LegacyCompatible, true)
+	m.LegacyCompatibleSubscriptionOfferId = stringFromAPI(in.LegacyCompatibleSubscriptionOfferId, true)
+	m.ProrationMode = stringFromAPI(in.ProrationMode, true)
+	m.ResubscribeState = stringFromAPI(in.ResubscribeState, true)
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m AutoRenewingBasePlanTypeModel) ToAPI(ctx context.Context) (*androidpublisher.AutoRenewingBasePlanType, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.AutoRenewingBasePlanType{}
+	out.AccountHoldDuration = m.AccountHoldDuration.ValueString()
+	out.BillingPeriodDuration = m.BillingPeriodDuration.ValueString()
+	out.GracePeriodDuration = m.GracePeriodDuration.ValueString()
+	out.LegacyCompatible = m.LegacyCompatible.ValueBool()
+	out.LegacyCompatibleSubscriptionOfferId = m.LegacyCompatibleSubscriptionOfferId.ValueString()
+	out.ProrationMode = m.ProrationMode.ValueString()
+	out.ResubscribeState = m.ResubscribeState.ValueString()
+	return out, diags
+}
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// BasePlanModel is the Terraform model of androidpublisher.BasePlan. A single
+// base plan for a subscription.
+type BasePlanModel struct {
+	AutoRenewingBasePlanType types.Object `tfsdk:"auto_renewing_base_plan_type"`
+	BasePlanId               types.String `tfsdk:"base_plan_id"`
+	InstallmentsBasePlanType types.Object `tfsdk:"installments_base_plan_type"`
+	OfferTags                types.List   `tfsdk:"offer_tags"`
+	OtherRegionsConfig       types.Object `tfsdk:"other_regions_config"`
+	PrepaidBasePlanType      types.Object `tfsdk:"prepaid_base_plan_type"`
+	RegionalConfigs          types.List   `tfsdk:"regional_configs"`
+	State                    types.String `tfsdk:"state"`
+}
+
+// BasePlanAttrTypes are the attribute types of BasePlanModel.
+var BasePlanAttrTypes = map[string]attr.Type{
+	"auto_renewing_base_plan_type": types.ObjectType{AttrTypes: AutoRenewingBasePlanTypeAttrTypes},
+	"base_plan_id":                 types.StringType,
+	"installments_base_plan_type":  types.ObjectType{AttrTypes: InstallmentsBasePlanTypeAttrTypes},
+	"offer_tags":                   types.ListType{ElemType: types.ObjectType{AttrTypes: OfferTagAttrTypes}},
+	"other_regions_config":         types.ObjectType{AttrTypes: OtherRegionsBasePlanConfigAttrTypes},
+	"prepaid_base_plan_type":       types.ObjectType{AttrTypes: PrepaidBasePlanTypeAttrTypes},
+	"regional_configs":             types.ListType{ElemType: types.ObjectType{AttrTypes: RegionalBasePlanConfigAttrTypes}},
+	"state":                        types.StringType,
+}
+
+// BasePlanAttributes returns the resource schema attributes of BasePlanModel.
+func BasePlanAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"auto_renewing_base_plan_type": schema.SingleNestedAttribute{
+			MarkdownDescription: "Set when the base plan automatically renews at a regular interval.",
+			Optional:            true,
+			Attributes:          AutoRenewingBasePlanTypeAttributes(),
+		},
+		"base_plan_id": schema.StringAttribute{
+			MarkdownDescription: "Required. Immutable. The unique identifier of this base plan. Must be unique within the subscription, and conform with RFC-1034. That is, this ID can only contain lower-case letters (a-z), numbers (0-9), and hyphens (-), and be at most 63 characters.",
+			Optional:            true,
+		},
+		"installments_base_plan_type": schema.SingleNestedAttribute{
+			MarkdownDescription: "Set for installments base plans where a user is committed to a specified number of payments.",
+			Optional:            true,
+			Attributes:          InstallmentsBasePlanTypeAttributes(),
+		},
+		"offer_tags": schema.ListNestedAttribute{
+			MarkdownDescription: "List of up to 20 custom tags specified for this base plan, and returned to the app through the billing library. Subscription offers for this base plan will also receive these offer tags in the billing library.",
+			Optional:            true,
+			NestedObject:        schema.NestedAttributeObject{Attributes: OfferTagAttributes()},
+		},
+		"other_regions_config": schema.SingleNestedAttribute{
+			MarkdownDescription: "Pricing information for any new locations Play may launch in the future. If omitted, the BasePlan will not be automatically available any new locations Play may launch in the future.",
+			Optional:            true,
+			Attributes:          OtherRegionsBasePlanConfigAttributes(),
+		},
+		"prepaid_base_plan_type": schema.SingleNestedAttribute{
+			MarkdownDescription: "Set when the base plan does not automatically renew at the end of the billing period.",
+			Optional:            true,
+			Attributes:          PrepaidBasePlanTypeAttributes(),
+		},
+		"regional_configs": schema.ListNestedAttribute{
+			MarkdownDescription: "Region-specific information for this base plan.",
+			Optional:            true,
+			NestedObject:        schema.NestedAttributeObject{Attributes: RegionalBasePlanConfigAttributes()},
+		},
+		"state": schema.StringAttribute{
+			MarkdownDescription: "Output only. The state of the base plan, i.e. whether it's active. Draft and inactive base plans can be activated or deleted. Active base plans can be made inactive. Inactive base plans can be canceled. This field cannot be changed by updating the resource. Use the dedicated endpoints instead. Possible values are `STATE_UNSPECIFIED`, `DRAFT`, `ACTIVE`, `INACTIVE`.",
+			Computed:            true,
+		},
+	}
+}
+
+// BasePlanDataSourceAttributes returns the data source schema attributes of BasePlanModel,
+// which are all computed.
+func BasePlanDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"auto_renewing_base_plan_type": dsschema.SingleNestedAttribute{
+			MarkdownDescription: "Set when the base plan automatically renews at a regular interval.",
+			Computed:            true,
+			Attributes:          AutoRenewingBasePlanTypeDataSourceAttributes(),
+		},
+		"base_plan_id": dsschema.StringAttribute{
+			MarkdownDescription: "Required. Immutable. The unique identifier of this base plan. Must be unique within the subscription, and conform with RFC-1034. That is, this ID can only contain lower-case letters (a-z), numbers (0-9), and hyphens (-), and be at most 63 characters.",
+			Computed:            true,
+		},
+		"installments_base_plan_type": dsschema.SingleNestedAttribute{
+			MarkdownDescription: "Set for installments base plans where a user is committed to a specified number of payments.",
+			Computed:            true,
+			Attributes:          InstallmentsBasePlanTypeDataSourceAttributes(),
+		},
+		"offer_tags": dsschema.ListNestedAttribute{
+			MarkdownDescription: "List of up to 20 custom tags specified for this base plan, and returned to the app through the billing library. Subscription offers for this base plan will also receive these offer tags in the billing library.",
+			Computed:            true,
+			NestedObject:        dsschema.NestedAttributeObject{Attributes: OfferTagDataSourceAttributes()},
+		},
+		"other_regions_config": dsschema.SingleNestedAttribute{
+			MarkdownDescription: "Pricing information for any new locations Play may launch in the future. If omitted, the BasePlan will not be automatically available any new locations Play may launch in the future.",
+			Computed:            true,
+			Attributes:          OtherRegionsBasePlanConfigDataSourceAttributes(),
+		},
+		"prepaid_base_plan_type": dsschema.SingleNestedAttribute{
+			MarkdownDescription: "Set when the base plan does not automatically renew at the end of the billing period.",
+			Computed:            true,
+			Attributes:          PrepaidBasePlanTypeDataSourceAttributes(),
+		},
+		"regional_configs": dsschema.ListNestedAttribute{
+			MarkdownDescription: "Region-specific information for this base plan.",
+			Computed:            true,
+			NestedObject:        dsschema.NestedAttributeObject{Attributes: RegionalBasePlanConfigDataSourceAttributes()},
+		},
+		"state": dsschema.StringAttribute{
+			MarkdownDescription: "Output only. The state of the base plan, i.e. whether it's active. Draft and inactive base plans can be activated or deleted. Active base plans can be made inactive. Inactive base plans can be canceled. This field cannot be changed by updating the resource. Use the dedicated endpoints instead. Possible values are `STATE_UNSPECIFIED`, `DRAFT`, `ACTIVE`, `INACTIVE`.",
+			Computed:            true,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *BasePlanModel) FromAPI(ctx context.Context, in *androidpublisher.BasePlan) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.BasePlan{}
+	}
+	if in.AutoRenewingBasePlanType != nil {
+		var model AutoRenewingBasePlanTypeModel
+		diags.Append(model.FromAPI(ctx, in.AutoRenewingBasePlanType)...)
+		value, d := types.ObjectValueFrom(ctx, AutoRenewingBasePlanTypeAttrTypes, model)
+		diags.Append(d...)
+		m.AutoRenewingBasePlanType = value
+	} else {
+		m.AutoRenewingBasePlanType = types.ObjectNull(AutoRenewingBasePlanTypeAttrTypes)
+	}
+	m.BasePlanId = stringFromAPI(in.BasePlanId, true)
+	if in.InstallmentsBasePlanType != nil {
+		var model InstallmentsBasePlanTypeModel
+		diags.Append(model.FromAPI(ctx, in.InstallmentsBasePlanType)...)
+		value, d := types.ObjectValueFrom(ctx, InstallmentsBasePlanTypeAttrTypes, model)
+		diags.Append(d...)
+		m.InstallmentsBasePlanType = value
+	} else {
+		m.InstallmentsBasePlanType = types.ObjectNull(InstallmentsBasePlanTypeAttrTypes)
+	}
+	{
+		models := make([]OfferTagModel, 0, len(in.OfferTags))
+		for _, item := range in.OfferTags {
+			if item == nil {
+				continue
+			}
+			var model OfferTagModel
+			diags.Append(model.FromAPI(ctx, item)...)
+			models = append(models, model)
+		}
+		value, d := listFromAPI(ctx, types.ObjectType{AttrTypes: OfferTagAttrTypes}, models, true)
+		diags.Append(d...)
+		m.OfferTags = value
+	}
+	if in.OtherRegionsConfig != nil {
+		var model OtherRegionsBasePlanConfigModel
+		diags.Append(model.FromAPI(ctx, in.OtherRegionsConfig)...)
+		value, d := types.ObjectValueFrom(ctx, OtherRegionsBasePlanConfigAttrTypes, model)
+		diags.Append(d...)
+		m.OtherRegionsConfig = value
+	} else {
+		m.OtherRegionsConfig = types.ObjectNull(OtherRegionsBasePlanConfigAttrTypes)
+	}
+	if in.PrepaidBasePlanType != nil {
+		var model PrepaidBasePlanTypeModel
+		diags.Append(model.FromAPI(ctx, in.PrepaidBasePlanType)...)
+		value, d := types.ObjectValueFrom(ctx, PrepaidBasePlanTypeAttrTypes, model)
+		diags.Append(d...)
+		m.PrepaidBasePlanType = value
+	} else {
+		m.PrepaidBasePlanType = types.ObjectNull(PrepaidBasePlanTypeAttrTypes)
+	}
+	{
+		models := make([]RegionalBasePlanConfigModel, 0, len(in.RegionalConfigs))
+		for _, item := range in.RegionalConfigs {
+			if item == nil {
+				continue
+			}
+			var model RegionalBasePlanConfigModel
+			diags.Append(model.FromAPI(ctx, item)...)
+			models = append(models, model)
+		}
+		value, d := listFromAPI(ctx, types.ObjectType{AttrTypes: RegionalBasePlanConfigAttrTypes}, models, true)
+		diags.Append(d...)
+		m.RegionalConfigs = value
+	}
+	m.State = stringFromAPI(in.State, false)
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m BasePlanModel) ToAPI(ctx context.Context) (*androidpublisher.BasePlan, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.BasePlan{}
+	{
+		model, d := objectAs[AutoRenewingBasePlanTypeModel](ctx, m.AutoRenewingBasePlanType)
+		diags.Append(d...)
+		if model != nil {
+			out.AutoRenewingBasePlanType, d = model.ToAPI(ctx)
+			diags.Append(d...)
+		}
+	}
+	out.BasePlanId = m.BasePlanId.ValueString()
+	{
+		model, d := objectAs[InstallmentsBasePlanTypeModel](ctx, m.InstallmentsBasePlanType)
+		diags.Append(d...)
+		if model != nil {
+			out.InstallmentsBasePlanType, d = model.ToAPI(ctx)
+			diags.Append(d...)
+		}
+	}
+	{
+		var models []OfferTagModel
+		diags.Append(elementsAs(ctx, m.OfferTags, &models)...)
+		for _, model := range models {
+			item, d := model.ToAPI(ctx)
+			diags.Append(d...)
+			out.OfferTags = append(out.OfferTags, item)
+		}
+	}
+	{
+		model, d := objectAs[OtherRegionsBasePlanConfigModel](ctx, m.OtherRegionsConfig)
+		diags.Append(d...)
+		if model != nil {
+			out.OtherRegionsConfig, d = model.ToAPI(ctx)
+			diags.Append(d...)
+		}
+	}
+	{
+		model, d := objectAs[PrepaidBasePlanTypeModel](ctx, m.PrepaidBasePlanType)
+		diags.Append(d...)
+		if model != nil {
+			out.PrepaidBasePlanType, d = model.ToAPI(ctx)
+			diags.Append(d...)
+		}
+	}
+	{
+		var models []RegionalBasePlanConfigModel
+		diags.Append(elementsAs(ctx, m.RegionalConfigs, &models)...)
+		for _, model := range models {
+			item, d := model.ToAPI(ctx)
+			diags.Append(d...)
+			out.RegionalConfigs = append(out.RegionalConfigs, item)
+		}
+	}
+	out.State = m.State.ValueString()
+	return out, diags
+}
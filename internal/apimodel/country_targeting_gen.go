@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// CountryTargetingModel is the Terraform model of
+// androidpublisher.CountryTargeting. Country targeting specification.
+type CountryTargetingModel struct {
+	Countries          types.List `tfsdk:"countries"`
+	IncludeRestOfWorld types.Bool `tfsdk:"include_rest_of_world"`
+}
+
+// CountryTargetingAttrTypes are the attribute types of CountryTargetingModel.
+var CountryTargetingAttrTypes = map[string]attr.Type{
+	"countries":             types.ListType{ElemType: types.StringType},
+	"include_rest_of_world": types.BoolType,
+}
+
+// CountryTargetingAttributes returns the resource schema attributes of CountryTargetingModel.
+func CountryTargetingAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"countries": schema.ListAttribute{
+			MarkdownDescription: "Countries to target, specified as two letter [CLDR codes](https://unicode.org/cldr/charts/latest/supplemental/territory_containment_un_m_49.html).",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"include_rest_of_world": schema.BoolAttribute{
+			MarkdownDescription: "Include \"rest of world\" as well as explicitly targeted countries.",
+			Optional:            true,
+		},
+	}
+}
+
+// CountryTargetingDataSourceAttributes returns the data source schema attributes of CountryTargetingModel,
+// which are all computed.
+func CountryTargetingDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"countries": dsschema.ListAttribute{
+			MarkdownDescription: "Countries to target, specified as two letter [CLDR codes](https://unicode.org/cldr/charts/latest/supplemental/territory_containment_un_m_49.html).",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"include_rest_of_world": dsschema.BoolAttribute{
+			MarkdownDescription: "Include \"rest of world\" as well as explicitly targeted countries.",
+			Computed:            true,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *CountryTargetingModel) FromAPI(ctx context.Context, in *androidpublisher.CountryTargeting) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.CountryTargeting{}
+	}
+	{
+		value, d := listFromAPI(ctx, types.StringType, in.Countries, true)
+		diags.Append(d...)
+		m.Countries = value
+	}
+	m.IncludeRestOfWorld = boolFromAPI(in.IncludeRestOfWorld, true)
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m CountryTargetingModel) ToAPI(ctx context.Context) (*androidpublisher.CountryTargeting, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.CountryTargeting{}
+	diags.Append(elementsAs(ctx, m.Countries, &out.Countries)...)
+	out.IncludeRestOfWorld = m.IncludeRestOfWorld.ValueBool()
+	return out, diags
+}
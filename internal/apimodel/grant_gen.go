@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// GrantModel is the Terraform model of androidpublisher.Grant. An access grant
+// resource.
+type GrantModel struct {
+	AppLevelPermissions types.List   `tfsdk:"app_level_permissions"`
+	Name                types.String `tfsdk:"name"`
+	PackageName         types.String `tfsdk:"package_name"`
+}
+
+// GrantAttrTypes are the attribute types of GrantModel.
+var GrantAttrTypes = map[string]attr.Type{
+	"app_level_permissions": types.ListType{ElemType: types.StringType},
+	"name":                  types.StringType,
+	"package_name":          types.StringType,
+}
+
+// GrantAttributes returns the resource schema attributes of GrantModel.
+func GrantAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"app_level_permissions": schema.ListAttribute{
+			MarkdownDescription: "The permissions granted to the user for this app. Possible values are `APP_LEVEL_PERMISSION_UNSPECIFIED`, `CAN_ACCESS_APP`, `CAN_VIEW_FINANCIAL_DATA`, `CAN_MANAGE_PERMISSIONS`, `CAN_REPLY_TO_REVIEWS`, `CAN_MANAGE_PUBLIC_APKS`, `CAN_MANAGE_TRACK_APKS`, `CAN_MANAGE_TRACK_USERS`, `CAN_MANAGE_PUBLIC_LISTING`, `CAN_MANAGE_DRAFT_APPS`, `CAN_MANAGE_ORDERS`, `CAN_MANAGE_APP_CONTENT`, `CAN_VIEW_NON_FINANCIAL_DATA`, `CAN_VIEW_APP_QUALITY`, `CAN_MANAGE_DEEPLINKS`.",
+			Required:            true,
+			ElementType:         types.StringType,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Required. Resource name for this grant, following the pattern \"developers/{developer}/users/{email}/grants/{package_name}\". If this grant is for a draft app, the app ID will be used in this resource name instead of the package name.",
+			Computed:            true,
+		},
+		"package_name": schema.StringAttribute{
+			MarkdownDescription: "Immutable. The package name of the app. This will be empty for draft apps.",
+			Required:            true,
+		},
+	}
+}
+
+// GrantDataSourceAttributes returns the data source schema attributes of GrantModel,
+// which are all computed.
+func GrantDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"app_level_permissions": dsschema.ListAttribute{
+			MarkdownDescription: "The permissions granted to the user for this app. Possible values are `APP_LEVEL_PERMISSION_UNSPECIFIED`, `CAN_ACCESS_APP`, `CAN_VIEW_FINANCIAL_DATA`, `CAN_MANAGE_PERMISSIONS`, `CAN_REPLY_TO_REVIEWS`, `CAN_MANAGE_PUBLIC_APKS`, `CAN_MANAGE_TRACK_APKS`, `CAN_MANAGE_TRACK_USERS`, `CAN_MANAGE_PUBLIC_LISTING`, `CAN_MANAGE_DRAFT_APPS`, `CAN_MANAGE_ORDERS`, `CAN_MANAGE_APP_CONTENT`, `CAN_VIEW_NON_FINANCIAL_DATA`, `CAN_VIEW_APP_QUALITY`, `CAN_MANAGE_DEEPLINKS`.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"name": dsschema.StringAttribute{
+			MarkdownDescription: "Required. Resource name for this grant, following the pattern \"developers/{developer}/users/{email}/grants/{package_name}\". If this grant is for a draft app, the app ID will be used in this resource name instead of the package name.",
+			Computed:            true,
+		},
+		"package_name": dsschema.StringAttribute{
+			MarkdownDescription: "Immutable. The package name of the app. This will be empty for draft apps.",
+			Computed:            true,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *GrantModel) FromAPI(ctx context.Context, in *androidpublisher.Grant) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.Grant{}
+	}
+	{
+		value, d := listFromAPI(ctx, types.StringType, in.AppLevelPermissions, false)
+		diags.Append(d...)
+		m.AppLevelPermissions = value
+	}
+	m.Name = stringFromAPI(in.Name, false)
+	m.PackageName = stringFromAPI(in.PackageName, false)
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m GrantModel) ToAPI(ctx context.Context) (*androidpublisher.Grant, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.Grant{}
+	diags.Append(elementsAs(ctx, m.AppLevelPermissions, &out.AppLevelPermissions)...)
+	out.Name = m.Name.ValueString()
+	out.PackageName = m.PackageName.ValueString()
+	return out, diags
+}
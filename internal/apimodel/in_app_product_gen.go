@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// InAppProductModel is the Terraform model of androidpublisher.InAppProduct. An
+// in-app product. The resource for InappproductsService.
+type InAppProductModel struct {
+	DefaultLanguage                          types.String `tfsdk:"default_language"`
+	DefaultPrice                             types.Object `tfsdk:"default_price"`
+	GracePeriod                              types.String `tfsdk:"grace_period"`
+	Listings                                 types.Map    `tfsdk:"listings"`
+	ManagedProductTaxesAndComplianceSettings types.Object `tfsdk:"managed_product_taxes_and_compliance_settings"`
+	PackageName                              types.String `tfsdk:"package_name"`
+	Prices                                   types.Map    `tfsdk:"prices"`
+	PurchaseType                             types.String `tfsdk:"purchase_type"`
+	Sku                                      types.String `tfsdk:"sku"`
+	Status                                   types.String `tfsdk:"status"`
+	SubscriptionPeriod                       types.String `tfsdk:"subscription_period"`
+	SubscriptionTaxesAndComplianceSettings   types.Object `tfsdk:"subscription_taxes_and_compliance_settings"`
+	TrialPeriod                              types.String `tfsdk:"trial_period"`
+}
+
+// InAppProductAttrTypes are the attribute types of InAppProductModel.
+var InAppProductAttrTypes = map[string]attr.Type{
+	"default_language": types.StringType,
+	"default_price":    types.ObjectType{AttrTypes: PriceAttrTypes},
+	"grace_period":     types.StringType,
+	"listings":         types.MapType{ElemType: types.ObjectType{AttrTypes: InAppProductListingAttrTypes}},
+	"managed_product_taxes_and_compliance_settings": types.ObjectType{AttrTypes: ManagedProductTaxAndComplianceSettingsAttrTypes},
+	"package_name":        types.StringType,
+	"prices":              types.MapType{ElemType: types.ObjectType{AttrTypes: PriceAttrTypes}},
+	"purchase_type":       types.StringType,
+	"sku":                 types.StringType,
+	"status":              types.StringType,
+	"subscription_period": types.StringType,
+	"subscription_taxes_and_compliance_settings": types.ObjectType{AttrTypes: SubscriptionTaxAndComplianceSettingsAttrTypes},
+	"trial_period": types.StringType,
+}
+
+// InAppProductAttributes returns the resource schema attributes of InAppProductModel.
+func InAppProductAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"default_language": schema.StringAttribute{
+			MarkdownDescription: "Default language of the localized data, as defined by BCP-47. e.g. \"en-US\".",
+			Optional:            true,
+		},
+		"default_price": schema.SingleNestedAttribute{
+			MarkdownDescription: "Default price. Cannot be zero, as in-app products are never free. Always in the developer's Checkout merchant currency.",
+			Optional:            true,
+			Attributes:          PriceAttributes(),
+		},
+		"grace_period": schema.StringAttribute{
+			MarkdownDescription: "Grace period of the subscription, specified in ISO 8601 format. Allows developers to give their subscribers a grace period when the payment for the new recurrence period is declined. Acceptable values are P0D (zero days), P3D (three days), P7D (seven days), P14D (14 days), and P30D (30 days).",
+			Optional:            true,
+		},
+		"listings": schema.MapNestedAttribute{
+			MarkdownDescription: "List of localized title and description data. Map key is the language of the localized data, as defined by BCP-47, e.g. \"en-US\".",
+			Optional:            true,
+			NestedObject:        schema.NestedAttributeObject{Attributes: InAppProductListingAttributes()},
+		},
+		"managed_product_taxes_and_compliance_settings": schema.SingleNestedAttribute{
+			MarkdownDescription: "Details about taxes and legal compliance. Only applicable to managed products.",
+			Optional:            true,
+			Attributes:          ManagedProductTaxAndComplianceSettingsAttributes(),
+		},
+		"package_name": schema.StringAttribute{
+			MarkdownDescription: "Package name of the parent app.",
+			Required:            true,
+		},
+		"prices": schema.MapNestedAttribute{
+			MarkdownDescription: "Prices per buyer region. None of these can be zero, as in-app products are never free. Map key is region code, as defined by ISO 3166-2.",
+			Optional:            true,
+			NestedObject:        schema.NestedAttributeObject{Attributes: PriceAttributes()},
+		},
+		"purchase_type": schema.StringAttribute{
+			MarkdownDescription: "The type of the product, e.g. a recurring subscription. Possible values are `purchaseTypeUnspecified`, `managedUser`, `subscription`.",
+			Optional:            true,
+		},
+		"sku": schema.StringAttribute{
+			MarkdownDescription: "Stock-keeping-unit (SKU) of the product, unique within an app.",
+			Required:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "The status of the product, e.g. whether it's active. Possible values are `statusUnspecified`, `active`, `inactive`.",
+			Optional:            true,
+		},
+		"subscription_period": schema.StringAttribute{
+			MarkdownDescription: "Subscription period, specified in ISO 8601 format. Acceptable values are P1W (one week), P1M (one month), P3M (three months), P6M (six months), and P1Y (one year).",
+			Optional:            true,
+		},
+		"subscription_taxes_and_compliance_settings": schema.SingleNestedAttribute{
+			MarkdownDescription: "Details about taxes and legal compliance. Only applicable to subscription products.",
+			Optional:            true,
+			Attributes:          SubscriptionTaxAndComplianceSettingsAttributes(),
+		},
+		"trial_period": schema.StringAttribute{
+			MarkdownDescription: "Trial period, specified in ISO 8601 format. Acceptable values are anything between P7D (seven days) and P999D (999 days).",
+			Optional:            true,
+		},
+	}
+}
+
+// InAppProductDataSourceAttributes returns the data source schema attributes of InAppProductModel,
+// which are all computed.
+func InAppProductDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"default_language": dsschema.StringAttribute{
+			MarkdownDescription: "Default language of the localized data, as defined by BCP-47. e.g. \"en-US\".",
+			Computed:            true,
+		},
+		"default_price": dsschema.SingleNestedAttribute{
+			MarkdownDescription: "Default price. Cannot be zero, as in-app products are never free. Always in the developer's Checkout merchant currency.",
+			Computed:            true,
+			Attributes:          PriceDataSourceAttributes(),
+		},
+		"grace_period": dsschema.StringAttribute{
+			MarkdownDescription: "Grace period of the subscription, specified in ISO 8601 format. Allows developers to give their subscribers a grace period when the payment for the new recurrence period is declined. Acceptable values are P0D (zero days), P3D (three days), P7D (seven days), P14D (14 days), and P30D (30 days).",
+			Computed:            true,
+		},
+		"listings": dsschema.MapNestedAttribute{
+			MarkdownDescription: "List of localized title and description data. Map key is the language of the localized data, as defined by BCP-47, e.g. \"en-US\".",
+			Computed:            true,
+			NestedObject:        dsschema.NestedAttributeObject{Attributes: InAppProductListingDataSourceAttributes()},
+		},
+		"managed_product_taxes_and_compliance_settings": dsschema.SingleNestedAttribute{
+			MarkdownDescription: "Details about taxes and legal compliance. Only applicable to managed products.",
+			Computed:            true,
+			Attributes:          ManagedProductTaxAndComplianceSettingsDataSourceAttributes(),
+		},
+		"package_name": dsschema.StringAttribute{
+			MarkdownDescription: "Package name of the parent app.",
+			Computed:            true,
+		},
+		"prices": dsschema.MapNestedAttribute{
+			MarkdownDescription: "Prices per buyer region. None of these can be zero, as in-app products are never free. Map key is region code, as defined by ISO 3166-2.",
+			Computed:            true,
+			NestedObject:        dsschema.NestedAttributeObject{Attributes: PriceDataSourceAttributes()},
+		},
+		"purchase_type": dsschema.StringAttribute{
+			MarkdownDescription: "The type of the product, e.g. a recurring subscription. Possible values are `purchaseTypeUnspecified`, `managedUser`, `subscription`.",
+			Computed:            true,
+		},
+		"sku": dsschema.StringAttribute{
+			MarkdownDescription: "Stock-keeping-unit (SKU) of the product, unique within an app.",
+			Computed:            true,
+		},
+		"status": dsschema.StringAttribute{
+			MarkdownDescription: "The status of the product, e.g. whether it's active. Possible values are `statusUnspecified`, `active`, `inactive`.",
+			Computed:            true,
+		},
+		"subscription_period": dsschema.StringAttribute{
+			MarkdownDescription: "Subscription period, specified in ISO 8601 format. Acceptable values are P1W (one week), P1M (one month), P3M (three months), P6M (six months), and P1Y (one year).",
+			Computed:            true,
+		},
+		"subscription_taxes_and_compliance_settings": dsschema.SingleNestedAttribute{
+			MarkdownDescription: "Details about taxes and legal compliance. Only applicable to subscription products.",
+			Computed:            true,
+			Attributes:          SubscriptionTaxAndComplianceSettingsDataSourceAttributes(),
+		},
+		"trial_period": dsschema.StringAttribute{
+			MarkdownDescription: "Trial period, specified in ISO 8601 format. Acceptable values are anything between P7D (seven days) and P999D (999 days).",
+			Computed:            true,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *InAppProductModel) FromAPI(ctx context.Context, in *androidpublisher.InAppProduct) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.InAppProduct{}
+	}
+	m.DefaultLanguage = stringFromAPI(in.DefaultLanguage, true)
+	if in.DefaultPrice != nil {
+		var model PriceModel
+		diags.Append(model.FromAPI(ctx, in.DefaultPrice)...)
+		value, d := types.ObjectValueFrom(ctx, PriceAttrTypes, model)
+		diags.Append(d...)
+		m.DefaultPrice = value
+	} else {
+		m.DefaultPrice = types.ObjectNull(PriceAttrTypes)
+	}
+	m.GracePeriod = stringFromAPI(in.GracePeriod, true)
+	{
+		models := make(map[string]InAppProductListingModel, len(in.Listings))
+		for key, item := range in.Listings {
+			var model InAppProductListingModel
+			diags.Append(model.FromAPI(ctx, &item)...)
+			models[key] = model
+		}
+		value, d := mapFromAPI(ctx, types.ObjectType{AttrTypes: InAppProductListingAttrTypes}, models, true)
+		diags.Append(d...)
+		m.Listings = value
+	}
+	if in.ManagedProductTaxesAndComplianceSettings != nil {
+		var model ManagedProductTaxAndComplianceSettingsModel
+		diags.Append(model.FromAPI(ctx, in.ManagedProductTaxesAndComplianceSettings)...)
+		value, d := types.ObjectValueFrom(ctx, ManagedProductTaxAndComplianceSettingsAttrTypes, model)
+		diags.Append(d...)
+		m.ManagedProductTaxesAndComplianceSettings = value
+	} else {
+		m.ManagedProductTaxesAndComplianceSettings = types.ObjectNull(ManagedProductTaxAndComplianceSettingsAttrTypes)
+	}
+	m.PackageName = stringFromAPI(in.PackageName, false)
+	{
+		models := make(map[string]PriceModel, len(in.Prices))
+		for key, item := range in.Prices {
+			var model PriceModel
+			diags.Append(model.FromAPI(ctx, &item)...)
+			models[key] = model
+		}
+		value, d := mapFromAPI(ctx, types.ObjectType{AttrTypes: PriceAttrTypes}, models, true)
+		diags.Append(d...)
+		m.Prices = value
+	}
+	m.PurchaseType = stringFromAPI(in.PurchaseType, true)
+	m.Sku = stringFromAPI(in.Sku, false)
+	m.Status = stringFromAPI(in.Status, true)
+	m.SubscriptionPeriod = stringFromAPI(in.SubscriptionPeriod, true)
+	if in.SubscriptionTaxesAndComplianceSettings != nil {
+		var model SubscriptionTaxAndComplianceSettingsModel
+		diags.Append(model.FromAPI(ctx, in.SubscriptionTaxesAndComplianceSettings)...)
+		value, d := types.ObjectValueFrom(ctx, SubscriptionTaxAndComplianceSettingsAttrTypes, model)
+		diags.Append(d...)
+		m.SubscriptionTaxesAndComplianceSettings = value
+	} else {
+		m.SubscriptionTaxesAndComplianceSettings = types.ObjectNull(SubscriptionTaxAndComplianceSettingsAttrTypes)
+	}
+	m.TrialPeriod = stringFromAPI(in.TrialPeriod, true)
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m InAppProductModel) ToAPI(ctx context.Context) (*androidpublisher.InAppProduct, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.InAppProduct{}
+	out.DefaultLanguage = m.DefaultLanguage.ValueString()
+	{
+		model, d := objectAs[PriceModel](ctx, m.DefaultPrice)
+		diags.Append(d...)
+		if model != nil {
+			out.DefaultPrice, d = model.ToAPI(ctx)
+			diags.Append(d...)
+		}
+	}
+	out.GracePeriod = m.GracePeriod.ValueString()
+	{
+		var models map[string]InAppProductListingModel
+		diags.Append(elementsAs(ctx, m.Listings, &models)...)
+		for key, model := range models {
+			item, d := model.ToAPI(ctx)
+			diags.Append(d...)
+			if out.Listings == nil {
+				out.Listings = make(map[string]androidpublisher.InAppProductListing, len(models))
+			}
+			out.Listings[key] = *item
+		}
+	}
+	{
+		model, d := objectAs[ManagedProductTaxAndComplianceSettingsModel](ctx, m.ManagedProductTaxesAndComplianceSettings)
+		diags.Append(d...)
+		if model != nil {
+			out.ManagedProductTaxesAndComplianceSettings, d = model.ToAPI(ctx)
+			diags.Append(d...)
+		}
+	}
+	out.PackageName = m.PackageName.ValueString()
+	{
+		var models map[string]PriceModel
+		diags.Append(elementsAs(ctx, m.Prices, &models)...)
+		for key, model := range models {
+			item, d := model.ToAPI(ctx)
+			diags.Append(d...)
+			if out.Prices == nil {
+				out.Prices = make(map[string]androidpublisher.Price, len(models))
+			}
+			out.Prices[key] = *item
+		}
+	}
+	out.PurchaseType = m.PurchaseType.ValueString()
+	out.Sku = m.Sku.ValueString()
+	out.Status = m.Status.ValueString()
+	out.SubscriptionPeriod = m.SubscriptionPeriod.ValueString()
+	{
+		model, d := objectAs[SubscriptionTaxAndComplianceSettingsModel](ctx, m.SubscriptionTaxesAndComplianceSettings)
+		diags.Append(d...)
+		if model != nil {
+			out.SubscriptionTaxesAndComplianceSettings, d = model.ToAPI(ctx)
+			diags.Append(d...)
+		}
+	}
+	out.TrialPeriod = m.TrialPeriod.ValueString()
+	return out, diags
+}
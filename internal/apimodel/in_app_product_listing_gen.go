@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// InAppProductListingModel is the Terraform model of
+// androidpublisher.InAppProductListing. Store listing of a single in-app
+// product.
+type InAppProductListingModel struct {
+	Benefits    types.List   `tfsdk:"benefits"`
+	Description types.String `tfsdk:"description"`
+	Title       types.String `tfsdk:"title"`
+}
+
+// InAppProductListingAttrTypes are the attribute types of InAppProductListingModel.
+var InAppProductListingAttrTypes = map[string]attr.Type{
+	"benefits":    types.ListType{ElemType: types.StringType},
+	"description": types.StringType,
+	"title":       types.StringType,
+}
+
+// InAppProductListingAttributes returns the resource schema attributes of InAppProductListingModel.
+func InAppProductListingAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"benefits": schema.ListAttribute{
+			MarkdownDescription: "Localized entitlement benefits for a subscription.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description for the store listing.",
+			Optional:            true,
+		},
+		"title": schema.StringAttribute{
+			MarkdownDescription: "Title for the store listing.",
+			Optional:            true,
+		},
+	}
+}
+
+// InAppProductListingDataSourceAttributes returns the data source schema attributes of InAppProductListingModel,
+// which are all computed.
+func InAppProductListingDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"benefits": dsschema.ListAttribute{
+			MarkdownDescription: "Localized entitlement benefits for a subscription.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"description": dsschema.StringAttribute{
+			MarkdownDescription: "Description for the store listing.",
+			Computed:            true,
+		},
+		"title": dsschema.StringAttribute{
+			MarkdownDescription: "Title for the store listing.",
+			Computed:            true,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *InAppProductListingModel) FromAPI(ctx context.Context, in *androidpublisher.InAppProductListing) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.InAppProductListing{}
+	}
+	{
+		value, d := listFromAPI(ctx, types.StringType, in.Benefits, true)
+		diags.Append(d...)
+		m.Benefits = value
+	}
+	m.Description = stringFromAPI(in.Description, true)
+	m.Title = stringFromAPI(in.Title, true)
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m InAppProductListingModel) ToAPI(ctx context.Context) (*androidpublisher.InAppProductListing, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.InAppProductListing{}
+	diags.Append(elementsAs(ctx, m.Benefits, &out.Benefits)...)
+	out.Description = m.Description.ValueString()
+	out.Title = m.Title.ValueString()
+	return out, diags
+}
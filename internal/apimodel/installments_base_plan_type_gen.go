@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// InstallmentsBasePlanTypeModel is the Terraform model of
+// androidpublisher.InstallmentsBasePlanType. Represents an installments base
+// plan where a user commits to a specified number of payments.
+type InstallmentsBasePlanTypeModel struct {
+	AccountHoldDuration    types.String `tfsdk:"account_hold_duration"`
+	BillingPeriodDuration  types.String `tfsdk:"billing_period_duration"`
+	CommittedPaymentsCount types.Int64  `tfsdk:"committed_payments_count"`
+	GracePeriodDuration    types.String `tfsdk:"grace_period_duration"`
+	ProrationMode          types.String `tfsdk:"proration_mode"`
+	RenewalType            types.String `tfsdk:"renewal_type"`
+	ResubscribeState       types.String `tfsdk:"resubscribe_state"`
+}
+
+// InstallmentsBasePlanTypeAttrTypes are the attribute types of InstallmentsBasePlanTypeModel.
+var InstallmentsBasePlanTypeAttrTypes = map[string]attr.Type{
+	"account_hold_duration":    types.StringType,
+	"billing_period_duration":  types.StringType,
+	"committed_payments_count": types.Int64Type,
+	"grace_period_duration":    types.StringType,
+	"proration_mode":           types.StringType,
+	"renewal_type":             types.StringType,
+	"resubscribe_state":        types.StringType,
+}
+
+// InstallmentsBasePlanTypeAttributes returns the resource schema attributes of InstallmentsBasePlanTypeModel.
+func InstallmentsBasePlanTypeAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"account_hold_duration": schema.StringAttribute{
+			MarkdownDescription: "Optional. Account hold period of the subscription, specified exclusively in days and in ISO 8601 format. Acceptable values are P0D (zero days) to P30D (30days). If not specified, the default value is P30D (30 days).",
+			Optional:            true,
+		},
+		"billing_period_duration": schema.StringAttribute{
+			MarkdownDescription: "Required. Immutable. Subscription period, specified in ISO 8601 format. For a list of acceptable billing periods, refer to the help center. The duration is immutable after the base plan is created.",
+			Optional:            true,
+		},
+		"committed_payments_count": schema.Int64Attribute{
+			MarkdownDescription: "Required. Immutable. The number of payments the user is committed to. It is immutable after the base plan is created.",
+			Optional:            true,
+		},
+		"grace_period_duration": schema.StringAttribute{
+			MarkdownDescription: "Grace period of the subscription, specified in ISO 8601 format. Acceptable values are P0D (zero days), P3D (3 days), P7D (7 days), P14D (14 days), and P30D (30 days). If not specified, a default value will be used based on the recurring period duration.",
+			Optional:            true,
+		},
+		"proration_mode": schema.StringAttribute{
+			MarkdownDescription: "The proration mode for the base plan determines what happens when a user switches to this plan from another base plan. If unspecified, defaults to CHARGE_ON_NEXT_BILLING_DATE. Possible values are `SUBSCRIPTION_PRORATION_MODE_UNSPECIFIED`, `SUBSCRIPTION_PRORATION_MODE_CHARGE_ON_NEXT_BILLING_DATE`, `SUBSCRIPTION_PRORATION_MODE_CHARGE_FULL_PRICE_IMMEDIATELY`.",
+			Optional:            true,
+		},
+		"renewal_type": schema.StringAttribute{
+			MarkdownDescription: "Required. Immutable. Installments base plan renewal type. Determines the behavior at the end of the initial commitment. The renewal type is immutable after the base plan is created. Possible values are `RENEWAL_TYPE_UNSPECIFIED`, `RENEWAL_TYPE_RENEWS_WITHOUT_COMMITMENT`, `RENEWAL_TYPE_RENEWS_WITH_COMMITMENT`.",
+			Optional:            true,
+		},
+		"resubscribe_state": schema.StringAttribute{
+			MarkdownDescription: "Whether users should be able to resubscribe to this base plan in Google Play surfaces. Defaults to RESUBSCRIBE_STATE_ACTIVE if not specified. Possible values are `RESUBSCRIBE_STATE_UNSPECIFIED`, `RESUBSCRIBE_STATE_ACTIVE`, `RESUBSCRIBE_STATE_INACTIVE`.",
+			Optional:            true,
+		},
+	}
+}
+
+// InstallmentsBasePlanTypeDataSourceAttributes returns the data source schema attributes of InstallmentsBasePlanTypeModel,
+// which are all computed.
+func InstallmentsBasePlanTypeDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"account_hold_duration": dsschema.StringAttribute{
+			MarkdownDescription: "Optional. Account hold period of the subscription, specified exclusively in days and in ISO 8601 format. Acceptable values are P0D (zero days) to P30D (30days). If not specified, the default value is P30D (30 days).",
+			Computed:            true,
+		},
+		"billing_period_duration": dsschema.StringAttribute{
+			MarkdownDescription: "Required. Immutable. Subscription period, specified in ISO 8601 format. For a list of acceptable billing periods, refer to the help center. The duration is immutable after the base plan is created.",
+			Computed:            true,
+		},
+		"committed_payments_count": dsschema.Int64Attribute{
+			MarkdownDescription: "Required. Immutable. The number of payments the user is committed to. It is immutable after the base plan is created.",
+			Computed:            true,
+		},
+		"grace_period_duration": dsschema.StringAttribute{
+			MarkdownDescription: "Grace period of the subscription, specified in ISO 8601 format. Acceptable values are P0D (zero days), P3D (3 days), P7D (7 days), P14D (14 days), and P30D (30 days). If not specified, a default value will be used based on the recurring period duration.",
+			Computed:            true,
+		},
+		"proration_mode": dsschema.StringAttribute{
+			MarkdownDescription: "The proration mode for the base plan determines what happens when a user switches to this plan from another base plan. If unspecified, defaults to CHARGE_ON_NEXT_BILLING_DATE. Possible values are `SUBSCRIPTION_PRORATION_MODE_UNSPECIFIED`, `SUBSCRIPTION_PRORATION_MODE_CHARGE_ON_NEXT_BILLING_DATE`, `SUBSCRIPTION_PRORATION_MODE_CHARGE_FULL_PRICE_IMMEDIATELY`.",
+			Computed:            true,
+		},
+		"renewal_type": dsschema.StringAttribute{
+			MarkdownDescription: "Required. Immutable. Installments base plan renewal type. Determines the behavior at the end of the initial commitment. The renewal type is immutable after the base plan is created. Possible values are `RENEWAL_TYPE_UNSPECIFIED`, `RENEWAL_TYPE_RENEWS_WITHOUT_COMMITMENT`, `RENEWAL_TYPE_RENEWS_WITH_COMMITMENT`.",
+			Computed:            true,
+		},
+		"resubscribe_state": dsschema.StringAttribute{
+			MarkdownDescription: "Whether users should be able to resubscribe to this base plan in Google Play surfaces. Defaults to RESUBSCRIBE_STATE_ACTIVE if not specified. Possible values are `RESUBSCRIBE_STATE_UNSPECIFIED`, `RESUBSCRIBE_STATE_ACTIVE`, `RESUBSCRIBE_STATE_INACTIVE`.",
+			Computed:            true,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *InstallmentsBasePlanTypeModel) FromAPI(ctx context.Context, in *androidpublisher.InstallmentsBasePlanType) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.InstallmentsBasePlanType{}
+	}
+	m.AccountHoldDuration = stringFromAPI(in.AccountHoldDuration, true)
+	m.BillingPeriodDuration = stringFromAPI(in.BillingPeriodDuration, true)
+	m.CommittedPaymentsCount = int64FromAPI(in.CommittedPaymentsCount, true)
+	m.GracePeriodDuration = stringFromAPI(in.GracePeriodDuration, true)
+	m.ProrationMode = stringFromAPI(in.ProrationMode, true)
+	m.RenewalType = stringFromAPI(in.RenewalType, true)
+	m.ResubscribeState = stringFromAPI(in.ResubscribeState, true)
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m InstallmentsBasePlanTypeModel) ToAPI(ctx context.Context) (*androidpublisher.InstallmentsBasePlanType, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.InstallmentsBasePlanType{}
+	out.AccountHoldDuration = m.AccountHoldDuration.ValueString()
+	out.BillingPeriodDuration = m.BillingPeriodDuration.ValueString()
+	out.CommittedPaymentsCount = m.CommittedPaymentsCount.ValueInt64()
+	out.GracePeriodDuration = m.GracePeriodDuration.ValueString()
+	out.ProrationMode = m.ProrationMode.ValueString()
+	out.RenewalType = m.RenewalType.ValueString()
+	out.ResubscribeState = m.ResubscribeState.ValueString()
+	return out, diags
+}
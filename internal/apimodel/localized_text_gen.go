@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// LocalizedTextModel is the Terraform model of androidpublisher.LocalizedText.
+// Localized text in given language.
+type LocalizedTextModel struct {
+	Language types.String `tfsdk:"language"`
+	Text     types.String `tfsdk:"text"`
+}
+
+// LocalizedTextAttrTypes are the attribute types of LocalizedTextModel.
+var LocalizedTextAttrTypes = map[string]attr.Type{
+	"language": types.StringType,
+	"text":     types.StringType,
+}
+
+// LocalizedTextAttributes returns the resource schema attributes of LocalizedTextModel.
+func LocalizedTextAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"language": schema.StringAttribute{
+			MarkdownDescription: "Language localization code (a BCP-47 language tag; for example, \"de-AT\" for Austrian German).",
+			Optional:            true,
+		},
+		"text": schema.StringAttribute{
+			MarkdownDescription: "The text in the given language.",
+			Optional:            true,
+		},
+	}
+}
+
+// LocalizedTextDataSourceAttributes returns the data source schema attributes of LocalizedTextModel,
+// which are all computed.
+func LocalizedTextDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"language": dsschema.StringAttribute{
+			MarkdownDescription: "Language localization code (a BCP-47 language tag; for example, \"de-AT\" for Austrian German).",
+			Computed:            true,
+		},
+		"text": dsschema.StringAttribute{
+			MarkdownDescription: "The text in the given language.",
+			Computed:            true,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *LocalizedTextModel) FromAPI(ctx context.Context, in *androidpublisher.LocalizedText) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.LocalizedText{}
+	}
+	m.Language = stringFromAPI(in.Language, true)
+	m.Text = stringFromAPI(in.Text, true)
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m LocalizedTextModel) ToAPI(ctx context.Context) (*androidpublisher.LocalizedText, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.LocalizedText{}
+	out.Language = m.Language.ValueString()
+	out.Text = m.Text.ValueString()
+	return out, diags
+}
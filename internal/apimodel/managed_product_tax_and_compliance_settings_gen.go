@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// ManagedProductTaxAndComplianceSettingsModel is the Terraform model of
+// androidpublisher.ManagedProductTaxAndComplianceSettings. Details about
+// taxation and legal compliance for managed products.
+type ManagedProductTaxAndComplianceSettingsModel struct {
+	EeaWithdrawalRightType  types.String `tfsdk:"eea_withdrawal_right_type"`
+	IsTokenizedDigitalAsset types.Bool   `tfsdk:"is_tokenized_digital_asset"`
+	TaxRateInfoByRegionCode types.Map    `tfsdk:"tax_rate_info_by_region_code"`
+}
+
+// ManagedProductTaxAndComplianceSettingsAttrTypes are the attribute types of ManagedProductTaxAndComplianceSettingsModel.
+var ManagedProductTaxAndComplianceSettingsAttrTypes = map[string]attr.Type{
+	"eea_withdrawal_right_type":    types.StringType,
+	"is_tokenized_digital_asset":   types.BoolType,
+	"tax_rate_info_by_region_code": types.MapType{ElemType: types.ObjectType{AttrTypes: RegionalTaxRateInfoAttrTypes}},
+}
+
+// ManagedProductTaxAndComplianceSettingsAttributes returns the resource schema attributes of ManagedProductTaxAndComplianceSettingsModel.
+func ManagedProductTaxAndComplianceSettingsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"eea_withdrawal_right_type": schema.StringAttribute{
+			MarkdownDescription: "Digital content or service classification for products distributed to users in the European Economic Area (EEA). The withdrawal regime under EEA consumer laws depends on this classification. Refer to the [Help Center article](https://support.google.com/googleplay/android-developer/answer/10463498) for more information. Possible values are `WITHDRAWAL_RIGHT_TYPE_UNSPECIFIED`, `WITHDRAWAL_RIGHT_DIGITAL_CONTENT`, `WITHDRAWAL_RIGHT_SERVICE`.",
+			Optional:            true,
+		},
+		"is_tokenized_digital_asset": schema.BoolAttribute{
+			MarkdownDescription: "Whether this in-app product is declared as a product representing a tokenized digital asset.",
+			Optional:            true,
+		},
+		"tax_rate_info_by_region_code": schema.MapNestedAttribute{
+			MarkdownDescription: "A mapping from region code to tax rate details. The keys are region codes as defined by Unicode's \"CLDR\".",
+			Optional:            true,
+			NestedObject:        schema.NestedAttributeObject{Attributes: RegionalTaxRateInfoAttributes()},
+		},
+	}
+}
+
+// ManagedProductTaxAndComplianceSettingsDataSourceAttributes returns the data source schema attributes of ManagedProductTaxAndComplianceSettingsModel,
+// which are all computed.
+func ManagedProductTaxAndComplianceSettingsDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"eea_withdrawal_right_type": dsschema.StringAttribute{
+			MarkdownDescription: "Digital content or service classification for products distributed to users in the European Economic Area (EEA). The withdrawal regime under EEA consumer laws depends on this classification. Refer to the [Help Center article](https://support.google.com/googleplay/android-developer/answer/10463498) for more information. Possible values are `WITHDRAWAL_RIGHT_TYPE_UNSPECIFIED`, `WITHDRAWAL_RIGHT_DIGITAL_CONTENT`, `WITHDRAWAL_RIGHT_SERVICE`.",
+			Computed:            true,
+		},
+		"is_tokenized_digital_asset": dsschema.BoolAttribute{
+			MarkdownDescription: "Whether this in-app product is declared as a product representing a tokenized digital asset.",
+			Computed:            true,
+		},
+		"tax_rate_info_by_region_code": dsschema.MapNestedAttribute{
+			MarkdownDescription: "A mapping from region code to tax rate details. The keys are region codes as defined by Unicode's \"CLDR\".",
+			Computed:            true,
+			NestedObject:        dsschema.NestedAttributeObject{Attributes: RegionalTaxRateInfoDataSourceAttributes()},
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *ManagedProductTaxAndComplianceSettingsModel) FromAPI(ctx context.Context, in *androidpublisher.ManagedProductTaxAndComplianceSettings) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.ManagedProductTaxAndComplianceSettings{}
+	}
+	m.EeaWithdrawalRightType = stringFromAPI(in.EeaWithdrawalRightType, true)
+	m.IsTokenizedDigitalAsset = boolFromAPI(in.IsTokenizedDigitalAsset, true)
+	{
+		models := make(map[string]RegionalTaxRateInfoModel, len(in.TaxRateInfoByRegionCode))
+		for key, item := range in.TaxRateInfoByRegionCode {
+			var model RegionalTaxRateInfoModel
+			diags.Append(model.FromAPI(ctx, &item)...)
+			models[key] = model
+		}
+		value, d := mapFromAPI(ctx, types.ObjectType{AttrTypes: RegionalTaxRateInfoAttrTypes}, models, true)
+		diags.Append(d...)
+		m.TaxRateInfoByRegionCode = value
+	}
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m ManagedProductTaxAndComplianceSettingsModel) ToAPI(ctx context.Context) (*androidpublisher.ManagedProductTaxAndComplianceSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.ManagedProductTaxAndComplianceSettings{}
+	out.EeaWithdrawalRightType = m.EeaWithdrawalRightType.ValueString()
+	out.IsTokenizedDigitalAsset = m.IsTokenizedDigitalAsset.ValueBool()
+	{
+		var models map[string]RegionalTaxRateInfoModel
+		diags.Append(elementsAs(ctx, m.TaxRateInfoByRegionCode, &models)...)
+		for key, model := range models {
+			item, d := model.ToAPI(ctx)
+			diags.Append(d...)
+			if out.TaxRateInfoByRegionCode == nil {
+				out.TaxRateInfoByRegionCode = make(map[string]androidpublisher.RegionalTaxRateInfo, len(models))
+			}
+			out.TaxRateInfoByRegionCode[key] = *item
+		}
+	}
+	return out, diags
+}
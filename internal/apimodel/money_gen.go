@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// MoneyModel is the Terraform model of androidpublisher.Money. Represents an
+// amount of money with its currency type.
+type MoneyModel struct {
+	CurrencyCode types.String `tfsdk:"currency_code"`
+	Nanos        types.Int64  `tfsdk:"nanos"`
+	Units        types.Int64  `tfsdk:"units"`
+}
+
+// MoneyAttrTypes are the attribute types of MoneyModel.
+var MoneyAttrTypes = map[string]attr.Type{
+	"currency_code": types.StringType,
+	"nanos":         types.Int64Type,
+	"units":         types.Int64Type,
+}
+
+// MoneyAttributes returns the resource schema attributes of MoneyModel.
+func MoneyAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"currency_code": schema.StringAttribute{
+			MarkdownDescription: "The three-letter currency code defined in ISO 4217.",
+			Optional:            true,
+		},
+		"nanos": schema.Int64Attribute{
+			MarkdownDescription: "Number of nano (10^-9) units of the amount. The value must be between -999,999,999 and +999,999,999 inclusive. If `units` is positive, `nanos` must be positive or zero. If `units` is zero, `nanos` can be positive, zero, or negative. If `units` is negative, `nanos` must be negative or zero. For example $-1.75 is represented as `units`=-1 and `nanos`=-750,000,000.",
+			Optional:            true,
+		},
+		"units": schema.Int64Attribute{
+			MarkdownDescription: "The whole units of the amount. For example if `currencyCode` is `\"USD\"`, then 1 unit is one US dollar.",
+			Optional:            true,
+		},
+	}
+}
+
+// MoneyDataSourceAttributes returns the data source schema attributes of MoneyModel,
+// which are all computed.
+func MoneyDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"currency_code": dsschema.StringAttribute{
+			MarkdownDescription: "The three-letter currency code defined in ISO 4217.",
+			Computed:            true,
+		},
+		"nanos": dsschema.Int64Attribute{
+			MarkdownDescription: "Number of nano (10^-9) units of the amount. The value must be between -999,999,999 and +999,999,999 inclusive. If `units` is positive, `nanos` must be positive or zero. If `units` is zero, `nanos` can be positive, zero, or negative. If `units` is negative, `nanos` must be negative or zero. For example $-1.75 is represented as `units`=-1 and `nanos`=-750,000,000.",
+			Computed:            true,
+		},
+		"units": dsschema.Int64Attribute{
+			MarkdownDescription: "The whole units of the amount. For example if `currencyCode` is `\"USD\"`, then 1 unit is one US dollar.",
+			Computed:            true,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *MoneyModel) FromAPI(ctx context.Context, in *androidpublisher.Money) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.Money{}
+	}
+	m.CurrencyCode = stringFromAPI(in.CurrencyCode, true)
+	m.Nanos = int64FromAPI(in.Nanos, true)
+	m.Units = int64FromAPI(in.Units, true)
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m MoneyModel) ToAPI(ctx context.Context) (*androidpublisher.Money, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.Money{}
+	out.CurrencyCode = m.CurrencyCode.ValueString()
+	out.Nanos = m.Nanos.ValueInt64()
+	out.Units = m.Units.ValueInt64()
+	return out, diags
+}
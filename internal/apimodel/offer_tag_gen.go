@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// OfferTagModel is the Terraform model of androidpublisher.OfferTag. Represents
+// a custom tag specified for base plans and subscription offers.
+type OfferTagModel struct {
+	Tag types.String `tfsdk:"tag"`
+}
+
+// OfferTagAttrTypes are the attribute types of OfferTagModel.
+var OfferTagAttrTypes = map[string]attr.Type{
+	"tag": types.StringType,
+}
+
+// OfferTagAttributes returns the resource schema attributes of OfferTagModel.
+func OfferTagAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"tag": schema.StringAttribute{
+			MarkdownDescription: "Must conform with RFC-1034. That is, this string can only contain lower-case letters (a-z), numbers (0-9), and hyphens (-), and be at most 20 characters.",
+			Optional:            true,
+		},
+	}
+}
+
+// OfferTagDataSourceAttributes returns the data source schema attributes of OfferTagModel,
+// which are all computed.
+func OfferTagDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"tag": dsschema.StringAttribute{
+			MarkdownDescription: "Must conform with RFC-1034. That is, this string can only contain lower-case letters (a-z), numbers (0-9), and hyphens (-), and be at most 20 characters.",
+			Computed:            true,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *OfferTagModel) FromAPI(ctx context.Context, in *androidpublisher.OfferTag) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.OfferTag{}
+	}
+	m.Tag = stringFromAPI(in.Tag, true)
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m OfferTagModel) ToAPI(ctx context.Context) (*androidpublisher.OfferTag, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.OfferTag{}
+	out.Tag = m.Tag.ValueString()
+	return out, diags
+}
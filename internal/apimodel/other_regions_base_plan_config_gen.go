@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// OtherRegionsBasePlanConfigModel is the Terraform model of
+// androidpublisher.OtherRegionsBasePlanConfig. Pricing information for any new
+// locations Play may launch in.
+type OtherRegionsBasePlanConfigModel struct {
+	EurPrice                  types.Object `tfsdk:"eur_price"`
+	NewSubscriberAvailability types.Bool   `tfsdk:"new_subscriber_availability"`
+	UsdPrice                  types.Object `tfsdk:"usd_price"`
+}
+
+// OtherRegionsBasePlanConfigAttrTypes are the attribute types of OtherRegionsBasePlanConfigModel.
+var OtherRegionsBasePlanConfigAttrTypes = map[string]attr.Type{
+	"eur_price":                   types.ObjectType{AttrTypes: MoneyAttrTypes},
+	"new_subscriber_availability": types.BoolType,
+	"usd_price":                   types.ObjectType{AttrTypes: MoneyAttrTypes},
+}
+
+// OtherRegionsBasePlanConfigAttributes returns the resource schema attributes of OtherRegionsBasePlanConfigModel.
+func OtherRegionsBasePlanConfigAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"eur_price": schema.SingleNestedAttribute{
+			MarkdownDescription: "Required. Price in EUR to use for any new locations Play may launch in.",
+			Optional:            true,
+			Attributes:          MoneyAttributes(),
+		},
+		"new_subscriber_availability": schema.BoolAttribute{
+			MarkdownDescription: "Whether the base plan is available for new subscribers in any new locations Play may launch in. If not specified, this will default to false.",
+			Optional:            true,
+		},
+		"usd_price": schema.SingleNestedAttribute{
+			MarkdownDescription: "Required. Price in USD to use for any new locations Play may launch in.",
+			Optional:            true,
+			Attributes:          MoneyAttributes(),
+		},
+	}
+}
+
+// OtherRegionsBasePlanConfigDataSourceAttributes returns the data source schema attributes of OtherRegionsBasePlanConfigModel,
+// which are all computed.
+func OtherRegionsBasePlanConfigDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"eur_price": dsschema.SingleNestedAttribute{
+			MarkdownDescription: "Required. Price in EUR to use for any new locations Play may launch in.",
+			Computed:            true,
+			Attributes:          MoneyDataSourceAttributes(),
+		},
+		"new_subscriber_availability": dsschema.BoolAttribute{
+			MarkdownDescription: "Whether the base plan is available for new subscribers in any new locations Play may launch in. If not specified, this will default to false.",
+			Computed:            true,
+		},
+		"usd_price": dsschema.SingleNestedAttribute{
+			MarkdownDescription: "Required. Price in USD to use for any new locations Play may launch in.",
+			Computed:            true,
+			Attributes:          MoneyDataSourceAttributes(),
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *OtherRegionsBasePlanConfigModel) FromAPI(ctx context.Context, in *androidpublisher.OtherRegionsBasePlanConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.OtherRegionsBasePlanConfig{}
+	}
+	if in.EurPrice != nil {
+		var model MoneyModel
+		diags.Append(model.FromAPI(ctx, in.EurPrice)...)
+		value, d := types.ObjectValueFrom(ctx, MoneyAttrTypes, model)
+		diags.Append(d...)
+		m.EurPrice = value
+	} else {
+		m.EurPrice = types.ObjectNull(MoneyAttrTypes)
+	}
+	m.NewSubscriberAvailability = boolFromAPI(in.NewSubscriberAvailability, true)
+	if in.UsdPrice != nil {
+		var model MoneyModel
+		diags.Append(model.FromAPI(ctx, in.UsdPrice)...)
+		value, d := types.ObjectValueFrom(ctx, MoneyAttrTypes, model)
+		diags.Append(d...)
+		m.UsdPrice = value
+	} else {
+		m.UsdPrice = types.ObjectNull(MoneyAttrTypes)
+	}
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m OtherRegionsBasePlanConfigModel) ToAPI(ctx context.Context) (*androidpublisher.OtherRegionsBasePlanConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.OtherRegionsBasePlanConfig{}
+	{
+		model, d := objectAs[MoneyModel](ctx, m.EurPrice)
+		diags.Append(d...)
+		if model != nil {
+			out.EurPrice, d = model.ToAPI(ctx)
+			diags.Append(d...)
+		}
+	}
+	out.NewSubscriberAvailability = m.NewSubscriberAvailability.ValueBool()
+	{
+		model, d := objectAs[MoneyModel](ctx, m.UsdPrice)
+		diags.Append(d...)
+		if model != nil {
+			out.UsdPrice, d = model.ToAPI(ctx)
+			diags.Append(d...)
+		}
+	}
+	return out, diags
+}
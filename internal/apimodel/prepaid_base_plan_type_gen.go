@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// PrepaidBasePlanTypeModel is the Terraform model of
+// androidpublisher.PrepaidBasePlanType. Represents a base plan that does not
+// automatically renew at the end of the base plan, and must be manually renewed
+// by the user.
+type PrepaidBasePlanTypeModel struct {
+	BillingPeriodDuration types.String `tfsdk:"billing_period_duration"`
+	TimeExtension         types.String `tfsdk:"time_extension"`
+}
+
+// PrepaidBasePlanTypeAttrTypes are the attribute types of PrepaidBasePlanTypeModel.
+var PrepaidBasePlanTypeAttrTypes = map[string]attr.Type{
+	"billing_period_duration": types.StringType,
+	"time_extension":          types.StringType,
+}
+
+// PrepaidBasePlanTypeAttributes returns the resource schema attributes of PrepaidBasePlanTypeModel.
+func PrepaidBasePlanTypeAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"billing_period_duration": schema.StringAttribute{
+			MarkdownDescription: "Required. Immutable. Subscription period, specified in ISO 8601 format. For a list of acceptable billing periods, refer to the help center. The duration is immutable after the base plan is created.",
+			Optional:            true,
+		},
+		"time_extension": schema.StringAttribute{
+			MarkdownDescription: "Whether users should be able to extend this prepaid base plan in Google Play surfaces. Defaults to TIME_EXTENSION_ACTIVE if not specified. Possible values are `TIME_EXTENSION_UNSPECIFIED`, `TIME_EXTENSION_ACTIVE`, `TIME_EXTENSION_INACTIVE`.",
+			Optional:            true,
+		},
+	}
+}
+
+// PrepaidBasePlanTypeDataSourceAttributes returns the data source schema attributes of PrepaidBasePlanTypeModel,
+// which are all computed.
+func PrepaidBasePlanTypeDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"billing_period_duration": dsschema.StringAttribute{
+			MarkdownDescription: "Required. Immutable. Subscription period, specified in ISO 8601 format. For a list of acceptable billing periods, refer to the help center. The duration is immutable after the base plan is created.",
+			Computed:            true,
+		},
+		"time_extension": dsschema.StringAttribute{
+			MarkdownDescription: "Whether users should be able to extend this prepaid base plan in Google Play surfaces. Defaults to TIME_EXTENSION_ACTIVE if not specified. Possible values are `TIME_EXTENSION_UNSPECIFIED`, `TIME_EXTENSION_ACTIVE`, `TIME_EXTENSION_INACTIVE`.",
+			Computed:            true,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *PrepaidBasePlanTypeModel) FromAPI(ctx context.Context, in *androidpublisher.PrepaidBasePlanType) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.PrepaidBasePlanType{}
+	}
+	m.BillingPeriodDuration = stringFromAPI(in.BillingPeriodDuration, true)
+	m.TimeExtension = stringFromAPI(in.TimeExtension, true)
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m PrepaidBasePlanTypeModel) ToAPI(ctx context.Context) (*androidpublisher.PrepaidBasePlanType, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.PrepaidBasePlanType{}
+	out.BillingPeriodDuration = m.BillingPeriodDuration.ValueString()
+	out.TimeExtension = m.TimeExtension.ValueString()
+	return out, diags
+}
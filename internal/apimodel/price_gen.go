@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// PriceModel is the Terraform model of androidpublisher.Price. Definition of a
+// price, i.e. currency and units.
+type PriceModel struct {
+	Currency    types.String `tfsdk:"currency"`
+	PriceMicros types.String `tfsdk:"price_micros"`
+}
+
+// PriceAttrTypes are the attribute types of PriceModel.
+var PriceAttrTypes = map[string]attr.Type{
+	"currency":     types.StringType,
+	"price_micros": types.StringType,
+}
+
+// PriceAttributes returns the resource schema attributes of PriceModel.
+func PriceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"currency": schema.StringAttribute{
+			MarkdownDescription: "3 letter Currency code, as defined by ISO 4217. See java/com/google/common/money/CurrencyCode.java",
+			Optional:            true,
+		},
+		"price_micros": schema.StringAttribute{
+			MarkdownDescription: "Price in 1/million of the currency base unit, represented as a string.",
+			Optional:            true,
+		},
+	}
+}
+
+// PriceDataSourceAttributes returns the data source schema attributes of PriceModel,
+// which are all computed.
+func PriceDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"currency": dsschema.StringAttribute{
+			MarkdownDescription: "3 letter Currency code, as defined by ISO 4217. See java/com/google/common/money/CurrencyCode.java",
+			Computed:            true,
+		},
+		"price_micros": dsschema.StringAttribute{
+			MarkdownDescription: "Price in 1/million of the currency base unit, represented as a string.",
+			Computed:            true,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *PriceModel) FromAPI(ctx context.Context, in *androidpublisher.Price) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.Price{}
+	}
+	m.Currency = stringFromAPI(in.Currency, true)
+	m.PriceMicros = stringFromAPI(in.PriceMicros, true)
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m PriceModel) ToAPI(ctx context.Context) (*androidpublisher.Price, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.Price{}
+	out.Currency = m.Currency.ValueString()
+	out.PriceMicros = m.PriceMicros.ValueString()
+	return out, diags
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// RegionalBasePlanConfigModel is the Terraform model of
+// androidpublisher.RegionalBasePlanConfig. Configuration for a base plan
+// specific to a region.
+type RegionalBasePlanConfigModel struct {
+	NewSubscriberAvailability types.Bool   `tfsdk:"new_subscriber_availability"`
+	Price                     types.Object `tfsdk:"price"`
+	RegionCode                types.String `tfsdk:"region_code"`
+}
+
+// RegionalBasePlanConfigAttrTypes are the attribute types of RegionalBasePlanConfigModel.
+var RegionalBasePlanConfigAttrTypes = map[string]attr.Type{
+	"new_subscriber_availability": types.BoolType,
+	"price":                       types.ObjectType{AttrTypes: MoneyAttrTypes},
+	"region_code":                 types.StringType,
+}
+
+// RegionalBasePlanConfigAttributes returns the resource schema attributes of RegionalBasePlanConfigModel.
+func RegionalBasePlanConfigAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"new_subscriber_availability": schema.BoolAttribute{
+			MarkdownDescription: "Whether the base plan in the specified region is available for new subscribers. Existing subscribers will not have their subscription canceled if this value is set to false. If not specified, this will default to false.",
+			Optional:            true,
+		},
+		"price": schema.SingleNestedAttribute{
+			MarkdownDescription: "The price of the base plan in the specified region. Must be set if the base plan is available to new subscribers. Must be set in the currency that is linked to the specified region.",
+			Optional:            true,
+			Attributes:          MoneyAttributes(),
+		},
+		"region_code": schema.StringAttribute{
+			MarkdownDescription: "Required. Region code this configuration applies to, as defined by ISO 3166-2, e.g. \"US\".",
+			Optional:            true,
+		},
+	}
+}
+
+// RegionalBasePlanConfigDataSourceAttributes returns the data source schema attributes of RegionalBasePlanConfigModel,
+// which are all computed.
+func RegionalBasePlanConfigDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"new_subscriber_availability": dsschema.BoolAttribute{
+			MarkdownDescription: "Whether the base plan in the specified region is available for new subscribers. Existing subscribers will not have their subscription canceled if this value is set to false. If not specified, this will default to false.",
+			Computed:            true,
+		},
+		"price": dsschema.SingleNestedAttribute{
+			MarkdownDescription: "The price of the base plan in the specified region. Must be set if the base plan is available to new subscribers. Must be set in the currency that is linked to the specified region.",
+			Computed:            true,
+			Attributes:          MoneyDataSourceAttributes(),
+		},
+		"region_code": dsschema.StringAttribute{
+			MarkdownDescription: "Required. Region code this configuration applies to, as defined by ISO 3166-2, e.g. \"US\".",
+			Computed:            true,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *RegionalBasePlanConfigModel) FromAPI(ctx context.Context, in *androidpublisher.RegionalBasePlanConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.RegionalBasePlanConfig{}
+	}
+	m.NewSubscriberAvailability = boolFromAPI(in.NewSubscriberAvailability, true)
+	if in.Price != nil {
+		var model MoneyModel
+		diags.Append(model.FromAPI(ctx, in.Price)...)
+		value, d := types.ObjectValueFrom(ctx, MoneyAttrTypes, model)
+		diags.Append(d...)
+		m.Price = value
+	} else {
+		m.Price = types.ObjectNull(MoneyAttrTypes)
+	}
+	m.RegionCode = stringFromAPI(in.RegionCode, true)
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m RegionalBasePlanConfigModel) ToAPI(ctx context.Context) (*androidpublisher.RegionalBasePlanConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.RegionalBasePlanConfig{}
+	out.NewSubscriberAvailability = m.NewSubscriberAvailability.ValueBool()
+	{
+		model, d := objectAs[MoneyModel](ctx, m.Price)
+		diags.Append(d...)
+		if model != nil {
+			out.Price, d = model.ToAPI(ctx)
+			diags.Append(d...)
+		}
+	}
+	out.RegionCode = m.RegionCode.ValueString()
+	return out, diags
+}
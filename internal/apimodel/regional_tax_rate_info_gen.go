@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// RegionalTaxRateInfoModel is the Terraform model of
+// androidpublisher.RegionalTaxRateInfo. Specified details about taxation in a
+// given geographical region.
+type RegionalTaxRateInfoModel struct {
+	EligibleForStreamingServiceTaxRate types.Bool   `tfsdk:"eligible_for_streaming_service_tax_rate"`
+	StreamingTaxType                   types.String `tfsdk:"streaming_tax_type"`
+	TaxTier                            types.String `tfsdk:"tax_tier"`
+}
+
+// RegionalTaxRateInfoAttrTypes are the attribute types of RegionalTaxRateInfoModel.
+var RegionalTaxRateInfoAttrTypes = map[string]attr.Type{
+	"eligible_for_streaming_service_tax_rate": types.BoolType,
+	"streaming_tax_type":                      types.StringType,
+	"tax_tier":                                types.StringType,
+}
+
+// RegionalTaxRateInfoAttributes returns the resource schema attributes of RegionalTaxRateInfoModel.
+func RegionalTaxRateInfoAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"eligible_for_streaming_service_tax_rate": schema.BoolAttribute{
+			MarkdownDescription: "You must tell us if your app contains streaming products to correctly charge US state and local sales tax. Field only supported in the United States.",
+			Optional:            true,
+		},
+		"streaming_tax_type": schema.StringAttribute{
+			MarkdownDescription: "To collect communications or amusement taxes in the United States, choose the appropriate tax category. [Learn more](https://support.google.com/googleplay/android-developer/answer/10463498#streaming_tax). Possible values are `STREAMING_TAX_TYPE_UNSPECIFIED`, `STREAMING_TAX_TYPE_TELCO_VIDEO_RENTAL`, `STREAMING_TAX_TYPE_TELCO_VIDEO_SALES`, `STREAMING_TAX_TYPE_TELCO_VIDEO_MULTI_CHANNEL`, `STREAMING_TAX_TYPE_TELCO_AUDIO_RENTAL`, `STREAMING_TAX_TYPE_TELCO_AUDIO_SALES`, `STREAMING_TAX_TYPE_TELCO_AUDIO_MULTI_CHANNEL`.",
+			Optional:            true,
+		},
+		"tax_tier": schema.StringAttribute{
+			MarkdownDescription: "Tax tier to specify reduced tax rate. Developers who sell digital news, magazines, newspapers, books, or audiobooks in various regions may be eligible for reduced tax rates. [Learn more](https://support.google.com/googleplay/android-developer/answer/10463498). Possible values are `TAX_TIER_UNSPECIFIED`, `TAX_TIER_BOOKS_1`, `TAX_TIER_NEWS_1`, `TAX_TIER_NEWS_2`, `TAX_TIER_MUSIC_OR_AUDIO_1`, `TAX_TIER_LIVE_OR_BROADCAST_1`.",
+			Optional:            true,
+		},
+	}
+}
+
+// RegionalTaxRateInfoDataSourceAttributes returns the data source schema attributes of RegionalTaxRateInfoModel,
+// which are all computed.
+func RegionalTaxRateInfoDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"eligible_for_streaming_service_tax_rate": dsschema.BoolAttribute{
+			MarkdownDescription: "You must tell us if your app contains streaming products to correctly charge US state and local sales tax. Field only supported in the United States.",
+			Computed:            true,
+		},
+		"streaming_tax_type": dsschema.StringAttribute{
+			MarkdownDescription: "To collect communications or amusement taxes in the United States, choose the appropriate tax category. [Learn more](https://support.google.com/googleplay/android-developer/answer/10463498#streaming_tax). Possible values are `STREAMING_TAX_TYPE_UNSPECIFIED`, `STREAMING_TAX_TYPE_TELCO_VIDEO_RENTAL`, `STREAMING_TAX_TYPE_TELCO_VIDEO_SALES`, `STREAMING_TAX_TYPE_TELCO_VIDEO_MULTI_CHANNEL`, `STREAMING_TAX_TYPE_TELCO_AUDIO_RENTAL`, `STREAMING_TAX_TYPE_TELCO_AUDIO_SALES`, `STREAMING_TAX_TYPE_TELCO_AUDIO_MULTI_CHANNEL`.",
+			Computed:            true,
+		},
+		"tax_tier": dsschema.StringAttribute{
+			MarkdownDescription: "Tax tier to specify reduced tax rate. Developers who sell digital news, magazines, newspapers, books, or audiobooks in various regions may be eligible for reduced tax rates. [Learn more](https://support.google.com/googleplay/android-developer/answer/10463498). Possible values are `TAX_TIER_UNSPECIFIED`, `TAX_TIER_BOOKS_1`, `TAX_TIER_NEWS_1`, `TAX_TIER_NEWS_2`, `TAX_TIER_MUSIC_OR_AUDIO_1`, `TAX_TIER_LIVE_OR_BROADCAST_1`.",
+			Computed:            true,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *RegionalTaxRateInfoModel) FromAPI(ctx context.Context, in *androidpublisher.RegionalTaxRateInfo) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.RegionalTaxRateInfo{}
+	}
+	m.EligibleForStreamingServiceTaxRate = boolFromAPI(in.EligibleForStreamingServiceTaxRate, true)
+	m.StreamingTaxType = stringFromAPI(in.StreamingTaxType, true)
+	m.TaxTier = stringFromAPI(in.TaxTier, true)
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m RegionalTaxRateInfoModel) ToAPI(ctx context.Context) (*androidpublisher.RegionalTaxRateInfo, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.RegionalTaxRateInfo{}
+	out.EligibleForStreamingServiceTaxRate = m.EligibleForStreamingServiceTaxRate.ValueBool()
+	out.StreamingTaxType = m.StreamingTaxType.ValueString()
+	out.TaxTier = m.TaxTier.ValueString()
+	return out, diags
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// RestrictedPaymentCountriesModel is the Terraform model of
+// androidpublisher.RestrictedPaymentCountries. Countries where the purchase of
+// this product is restricted to payment methods registered in the same country.
+// If empty, no payment location restrictions are imposed.
+type RestrictedPaymentCountriesModel struct {
+	RegionCodes types.List `tfsdk:"region_codes"`
+}
+
+// RestrictedPaymentCountriesAttrTypes are the attribute types of RestrictedPaymentCountriesModel.
+var RestrictedPaymentCountriesAttrTypes = map[string]attr.Type{
+	"region_codes": types.ListType{ElemType: types.StringType},
+}
+
+// RestrictedPaymentCountriesAttributes returns the resource schema attributes of RestrictedPaymentCountriesModel.
+func RestrictedPaymentCountriesAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"region_codes": schema.ListAttribute{
+			MarkdownDescription: "Required. Region codes to impose payment restrictions on, as defined by ISO 3166-2, e.g. \"US\".",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+	}
+}
+
+// RestrictedPaymentCountriesDataSourceAttributes returns the data source schema attributes of RestrictedPaymentCountriesModel,
+// which are all computed.
+func RestrictedPaymentCountriesDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"region_codes": dsschema.ListAttribute{
+			MarkdownDescription: "Required. Region codes to impose payment restrictions on, as defined by ISO 3166-2, e.g. \"US\".",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *RestrictedPaymentCountriesModel) FromAPI(ctx context.Context, in *androidpublisher.RestrictedPaymentCountries) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.RestrictedPaymentCountries{}
+	}
+	{
+		value, d := listFromAPI(ctx, types.StringType, in.RegionCodes, true)
+		diags.Append(d...)
+		m.RegionCodes = value
+	}
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m RestrictedPaymentCountriesModel) ToAPI(ctx context.Context) (*androidpublisher.RestrictedPaymentCountries, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.RestrictedPaymentCountries{}
+	diags.Append(elementsAs(ctx, m.RegionCodes, &out.RegionCodes)...)
+	return out, diags
+}
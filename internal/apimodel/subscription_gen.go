@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// SubscriptionModel is the Terraform model of androidpublisher.Subscription. A
+// single subscription for an app.
+type SubscriptionModel struct {
+	Archived                   types.Bool   `tfsdk:"archived"`
+	BasePlans                  types.List   `tfsdk:"base_plans"`
+	Listings                   types.List   `tfsdk:"listings"`
+	PackageName                types.String `tfsdk:"package_name"`
+	ProductId                  types.String `tfsdk:"product_id"`
+	RestrictedPaymentCountries types.Object `tfsdk:"restricted_payment_countries"`
+	TaxAndComplianceSettings   types.Object `tfsdk:"tax_and_compliance_settings"`
+}
+
+// SubscriptionAttrTypes are the attribute types of SubscriptionModel.
+var SubscriptionAttrTypes = map[string]attr.Type{
+	"archived":                     types.BoolType,
+	"base_plans":                   types.ListType{ElemType: types.ObjectType{AttrTypes: BasePlanAttrTypes}},
+	"listings":                     types.ListType{ElemType: types.ObjectType{AttrTypes: SubscriptionListingAttrTypes}},
+	"package_name":                 types.StringType,
+	"product_id":                   types.StringType,
+	"restricted_payment_countries": types.ObjectType{AttrTypes: RestrictedPaymentCountriesAttrTypes},
+	"tax_and_compliance_settings":  types.ObjectType{AttrTypes: SubscriptionTaxAndComplianceSettingsAttrTypes},
+}
+
+// SubscriptionAttributes returns the resource schema attributes of SubscriptionModel.
+func SubscriptionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"archived": schema.BoolAttribute{
+			MarkdownDescription: "Output only. Deprecated: subscription archiving is not supported.",
+			Computed:            true,
+		},
+		"base_plans": schema.ListNestedAttribute{
+			MarkdownDescription: "The set of base plans for this subscription. Represents the prices and duration of the subscription if no other offers apply.",
+			Optional:            true,
+			NestedObject:        schema.NestedAttributeObject{Attributes: BasePlanAttributes()},
+		},
+		"listings": schema.ListNestedAttribute{
+			MarkdownDescription: "Required. List of localized listings for this subscription. Must contain at least an entry for the default language of the parent app.",
+			Optional:            true,
+			NestedObject:        schema.NestedAttributeObject{Attributes: SubscriptionListingAttributes()},
+		},
+		"package_name": schema.StringAttribute{
+			MarkdownDescription: "Immutable. Package name of the parent app.",
+			Required:            true,
+		},
+		"product_id": schema.StringAttribute{
+			MarkdownDescription: "Immutable. Unique product ID of the product. Unique within the parent app. Product IDs must be composed of lower-case letters (a-z), numbers (0-9), underscores (_) and dots (.). It must start with a lower-case letter or number, and be between 1 and 40 (inclusive) characters in length.",
+			Required:            true,
+		},
+		"restricted_payment_countries": schema.SingleNestedAttribute{
+			MarkdownDescription: "Optional. Countries where the purchase of this subscription is restricted to payment methods registered in the same country. If empty, no payment location restrictions are imposed.",
+			Optional:            true,
+			Attributes:          RestrictedPaymentCountriesAttributes(),
+		},
+		"tax_and_compliance_settings": schema.SingleNestedAttribute{
+			MarkdownDescription: "Details about taxes and legal compliance.",
+			Optional:            true,
+			Attributes:          SubscriptionTaxAndComplianceSettingsAttributes(),
+		},
+	}
+}
+
+// SubscriptionDataSourceAttributes returns the data source schema attributes of SubscriptionModel,
+// which are all computed.
+func SubscriptionDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"archived": dsschema.BoolAttribute{
+			MarkdownDescription: "Output only. Deprecated: subscription archiving is not supported.",
+			Computed:            true,
+		},
+		"base_plans": dsschema.ListNestedAttribute{
+			MarkdownDescription: "The set of base plans for this subscription. Represents the prices and duration of the subscription if no other offers apply.",
+			Computed:            true,
+			NestedObject:        dsschema.NestedAttributeObject{Attributes: BasePlanDataSourceAttributes()},
+		},
+		"listings": dsschema.ListNestedAttribute{
+			MarkdownDescription: "Required. List of localized listings for this subscription. Must contain at least an entry for the default language of the parent app.",
+			Computed:            true,
+			NestedObject:        dsschema.NestedAttributeObject{Attributes: SubscriptionListingDataSourceAttributes()},
+		},
+		"package_name": dsschema.StringAttribute{
+			MarkdownDescription: "Immutable. Package name of the parent app.",
+			Computed:            true,
+		},
+		"product_id": dsschema.StringAttribute{
+			MarkdownDescription: "Immutable. Unique product ID of the product. Unique within the parent app. Product IDs must be composed of lower-case letters (a-z), numbers (0-9), underscores (_) and dots (.). It must start with a lower-case letter or number, and be between 1 and 40 (inclusive) characters in length.",
+			Computed:            true,
+		},
+		"restricted_payment_countries": dsschema.SingleNestedAttribute{
+			MarkdownDescription: "Optional. Countries where the purchase of this subscription is restricted to payment methods registered in the same country. If empty, no payment location restrictions are imposed.",
+			Computed:            true,
+			Attributes:          RestrictedPaymentCountriesDataSourceAttributes(),
+		},
+		"tax_and_compliance_settings": dsschema.SingleNestedAttribute{
+			MarkdownDescription: "Details about taxes and legal compliance.",
+			Computed:            true,
+			Attributes:          SubscriptionTaxAndComplianceSettingsDataSourceAttributes(),
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *SubscriptionModel) FromAPI(ctx context.Context, in *androidpublisher.Subscription) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.Subscription{}
+	}
+	m.Archived = boolFromAPI(in.Archived, false)
+	{
+		models := make([]BasePlanModel, 0, len(in.BasePlans))
+		for _, item := range in.BasePlans {
+			if item == nil {
+				continue
+			}
+			var model BasePlanModel
+			diags.Append(model.FromAPI(ctx, item)...)
+			models = append(models, model)
+		}
+		value, d := listFromAPI(ctx, types.ObjectType{AttrTypes: BasePlanAttrTypes}, models, true)
+		diags.Append(d...)
+		m.BasePlans = value
+	}
+	{
+		models := make([]SubscriptionListingModel, 0, len(in.Listings))
+		for _, item := range in.Listings {
+			if item == nil {
+				continue
+			}
+			var model SubscriptionListingModel
+			diags.Append(model.FromAPI(ctx, item)...)
+			models = append(models, model)
+		}
+		value, d := listFromAPI(ctx, types.ObjectType{AttrTypes: SubscriptionListingAttrTypes}, models, true)
+		diags.Append(d...)
+		m.Listings = value
+	}
+	m.PackageName = stringFromAPI(in.PackageName, false)
+	m.ProductId = stringFromAPI(in.ProductId, false)
+	if in.RestrictedPaymentCountries != nil {
+		var model RestrictedPaymentCountriesModel
+		diags.Append(model.FromAPI(ctx, in.RestrictedPaymentCountries)...)
+		value, d := types.ObjectValueFrom(ctx, RestrictedPaymentCountriesAttrTypes, model)
+		diags.Append(d...)
+		m.RestrictedPaymentCountries = value
+	} else {
+		m.RestrictedPaymentCountries = types.ObjectNull(RestrictedPaymentCountriesAttrTypes)
+	}
+	if in.TaxAndComplianceSettings != nil {
+		var model SubscriptionTaxAndComplianceSettingsModel
+		diags.Append(model.FromAPI(ctx, in.TaxAndComplianceSettings)...)
+		value, d := types.ObjectValueFrom(ctx, SubscriptionTaxAndComplianceSettingsAttrTypes, model)
+		diags.Append(d...)
+		m.TaxAndComplianceSettings = value
+	} else {
+		m.TaxAndComplianceSettings = types.ObjectNull(SubscriptionTaxAndComplianceSettingsAttrTypes)
+	}
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m SubscriptionModel) ToAPI(ctx context.Context) (*androidpublisher.Subscription, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.Subscription{}
+	out.Archived = m.Archived.ValueBool()
+	{
+		var models []BasePlanModel
+		diags.Append(elementsAs(ctx, m.BasePlans, &models)...)
+		for _, model := range models {
+			item, d := model.ToAPI(ctx)
+			diags.Append(d...)
+			out.BasePlans = append(out.BasePlans, item)
+		}
+	}
+	{
+		var models []SubscriptionListingModel
+		diags.Append(elementsAs(ctx, m.Listings, &models)...)
+		for _, model := range models {
+			item, d := model.ToAPI(ctx)
+			diags.Append(d...)
+			out.Listings = append(out.Listings, item)
+		}
+	}
+	out.PackageName = m.PackageName.ValueString()
+	out.ProductId = m.ProductId.ValueString()
+	{
+		model, d := objectAs[RestrictedPaymentCountriesModel](ctx, m.RestrictedPaymentCountries)
+		diags.Append(d...)
+		if model != nil {
+			out.RestrictedPaymentCountries, d = model.ToAPI(ctx)
+			diags.Append(d...)
+		}
+	}
+	{
+		model, d := objectAs[SubscriptionTaxAndComplianceSettingsModel](ctx, m.TaxAndComplianceSettings)
+		diags.Append(d...)
+		if model != nil {
+			out.TaxAndComplianceSettings, d = model.ToAPI(ctx)
+			diags.Append(d...)
+		}
+	}
+	return out, diags
+}
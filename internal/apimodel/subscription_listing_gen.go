@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// SubscriptionListingModel is the Terraform model of
+// androidpublisher.SubscriptionListing. The consumer-visible metadata of a
+// subscription.
+type SubscriptionListingModel struct {
+	Benefits     types.List   `tfsdk:"benefits"`
+	Description  types.String `tfsdk:"description"`
+	LanguageCode types.String `tfsdk:"language_code"`
+	Title        types.String `tfsdk:"title"`
+}
+
+// SubscriptionListingAttrTypes are the attribute types of SubscriptionListingModel.
+var SubscriptionListingAttrTypes = map[string]attr.Type{
+	"benefits":      types.ListType{ElemType: types.StringType},
+	"description":   types.StringType,
+	"language_code": types.StringType,
+	"title":         types.StringType,
+}
+
+// SubscriptionListingAttributes returns the resource schema attributes of SubscriptionListingModel.
+func SubscriptionListingAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"benefits": schema.ListAttribute{
+			MarkdownDescription: "A list of benefits shown to the user on platforms such as the Play Store and in restoration flows in the language of this listing. Plain text. Ordered list of at most four benefits.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of this subscription in the language of this listing. Maximum length - 80 characters. Plain text.",
+			Optional:            true,
+		},
+		"language_code": schema.StringAttribute{
+			MarkdownDescription: "Required. The language of this listing, as defined by BCP-47, e.g. \"en-US\".",
+			Optional:            true,
+		},
+		"title": schema.StringAttribute{
+			MarkdownDescription: "Required. The title of this subscription in the language of this listing. Plain text.",
+			Optional:            true,
+		},
+	}
+}
+
+// SubscriptionListingDataSourceAttributes returns the data source schema attributes of SubscriptionListingModel,
+// which are all computed.
+func SubscriptionListingDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"benefits": dsschema.ListAttribute{
+			MarkdownDescription: "A list of benefits shown to the user on platforms such as the Play Store and in restoration flows in the language of this listing. Plain text. Ordered list of at most four benefits.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"description": dsschema.StringAttribute{
+			MarkdownDescription: "The description of this subscription in the language of this listing. Maximum length - 80 characters. Plain text.",
+			Computed:            true,
+		},
+		"language_code": dsschema.StringAttribute{
+			MarkdownDescription: "Required. The language of this listing, as defined by BCP-47, e.g. \"en-US\".",
+			Computed:            true,
+		},
+		"title": dsschema.StringAttribute{
+			MarkdownDescription: "Required. The title of this subscription in the language of this listing. Plain text.",
+			Computed:            true,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *SubscriptionListingModel) FromAPI(ctx context.Context, in *androidpublisher.SubscriptionListing) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.SubscriptionListing{}
+	}
+	{
+		value, d := listFromAPI(ctx, types.StringType, in.Benefits, true)
+		diags.Append(d...)
+		m.Benefits = value
+	}
+	m.Description = stringFromAPI(in.Description, true)
+	m.LanguageCode = stringFromAPI(in.LanguageCode, true)
+	m.Title = stringFromAPI(in.Title, true)
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m SubscriptionListingModel) ToAPI(ctx context.Context) (*androidpublisher.SubscriptionListing, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.SubscriptionListing{}
+	diags.Append(elementsAs(ctx, m.Benefits, &out.Benefits)...)
+	out.Description = m.Description.ValueString()
+	out.LanguageCode = m.LanguageCode.ValueString()
+	out.Title = m.Title.ValueString()
+	return out, diags
+}
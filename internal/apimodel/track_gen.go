@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// TrackModel is the Terraform model of androidpublisher.Track. A track
+// configuration. The resource for TracksService.
+type TrackModel struct {
+	Releases types.List   `tfsdk:"releases"`
+	Track    types.String `tfsdk:"track"`
+}
+
+// TrackAttrTypes are the attribute types of TrackModel.
+var TrackAttrTypes = map[string]attr.Type{
+	"releases": types.ListType{ElemType: types.ObjectType{AttrTypes: TrackReleaseAttrTypes}},
+	"track":    types.StringType,
+}
+
+// TrackAttributes returns the resource schema attributes of TrackModel.
+func TrackAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"releases": schema.ListNestedAttribute{
+			MarkdownDescription: "In a read request, represents all active releases in the track. In an update request, represents desired changes.",
+			Optional:            true,
+			NestedObject:        schema.NestedAttributeObject{Attributes: TrackReleaseAttributes()},
+		},
+		"track": schema.StringAttribute{
+			MarkdownDescription: "Identifier of the track. Form factor tracks have a special prefix as an identifier, for example `wear:production`, `automotive:production`. [More on track name](https://developers.google.com/android-publisher/tracks#ff-track-name)",
+			Required:            true,
+		},
+	}
+}
+
+// TrackDataSourceAttributes returns the data source schema attributes of TrackModel,
+// which are all computed.
+func TrackDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"releases": dsschema.ListNestedAttribute{
+			MarkdownDescription: "In a read request, represents all active releases in the track. In an update request, represents desired changes.",
+			Computed:            true,
+			NestedObject:        dsschema.NestedAttributeObject{Attributes: TrackReleaseDataSourceAttributes()},
+		},
+		"track": dsschema.StringAttribute{
+			MarkdownDescription: "Identifier of the track. Form factor tracks have a special prefix as an identifier, for example `wear:production`, `automotive:production`. [More on track name](https://developers.google.com/android-publisher/tracks#ff-track-name)",
+			Computed:            true,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *TrackModel) FromAPI(ctx context.Context, in *androidpublisher.Track) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.Track{}
+	}
+	{
+		models := make([]TrackReleaseModel, 0, len(in.Releases))
+		for _, item := range in.Releases {
+			if item == nil {
+				continue
+			}
+			var model TrackReleaseModel
+			diags.Append(model.FromAPI(ctx, item)...)
+			models = append(models, model)
+		}
+		value, d := listFromAPI(ctx, types.ObjectType{AttrTypes: TrackReleaseAttrTypes}, models, true)
+		diags.Append(d...)
+		m.Releases = value
+	}
+	m.Track = stringFromAPI(in.Track, false)
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m TrackModel) ToAPI(ctx context.Context) (*androidpublisher.Track, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.Track{}
+	{
+		var models []TrackReleaseModel
+		diags.Append(elementsAs(ctx, m.Releases, &models)...)
+		for _, model := range models {
+			item, d := model.ToAPI(ctx)
+			diags.Append(d...)
+			out.Releases = append(out.Releases, item)
+		}
+	}
+	out.Track = m.Track.ValueString()
+	return out, diags
+}
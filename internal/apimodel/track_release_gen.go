@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// TrackReleaseModel is the Terraform model of androidpublisher.TrackRelease. A
+// release within a track.
+type TrackReleaseModel struct {
+	CountryTargeting    types.Object  `tfsdk:"country_targeting"`
+	InAppUpdatePriority types.Int64   `tfsdk:"in_app_update_priority"`
+	Name                types.String  `tfsdk:"name"`
+	ReleaseNotes        types.List    `tfsdk:"release_notes"`
+	Status              types.String  `tfsdk:"status"`
+	UserFraction        types.Float64 `tfsdk:"user_fraction"`
+	VersionCodes        types.List    `tfsdk:"version_codes"`
+}
+
+// TrackReleaseAttrTypes are the attribute types of TrackReleaseModel.
+var TrackReleaseAttrTypes = map[string]attr.Type{
+	"country_targeting":      types.ObjectType{AttrTypes: CountryTargetingAttrTypes},
+	"in_app_update_priority": types.Int64Type,
+	"name":                   types.StringType,
+	"release_notes":          types.ListType{ElemType: types.ObjectType{AttrTypes: LocalizedTextAttrTypes}},
+	"status":                 types.StringType,
+	"user_fraction":          types.Float64Type,
+	"version_codes":          types.ListType{ElemType: types.Int64Type},
+}
+
+// TrackReleaseAttributes returns the resource schema attributes of TrackReleaseModel.
+func TrackReleaseAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"country_targeting": schema.SingleNestedAttribute{
+			MarkdownDescription: "Restricts a release to a specific set of countries.",
+			Optional:            true,
+			Attributes:          CountryTargetingAttributes(),
+		},
+		"in_app_update_priority": schema.Int64Attribute{
+			MarkdownDescription: "In-app update priority of the release. All newly added APKs in the release will be considered at this priority. Can take values in the range [0, 5], with 5 the highest priority. Defaults to 0. in_app_update_priority can not be updated once the release is rolled out. See https://developer.android.com/guide/playcore/in-app-updates.",
+			Optional:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The release name. Not required to be unique. If not set, the name is generated from the APK's version_name. If the release contains multiple APKs, the name is generated from the date.",
+			Optional:            true,
+		},
+		"release_notes": schema.ListNestedAttribute{
+			MarkdownDescription: "A description of what is new in this release.",
+			Optional:            true,
+			NestedObject:        schema.NestedAttributeObject{Attributes: LocalizedTextAttributes()},
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "The status of the release. Possible values are `statusUnspecified`, `draft`, `inProgress`, `halted`, `completed`.",
+			Optional:            true,
+		},
+		"user_fraction": schema.Float64Attribute{
+			MarkdownDescription: "Fraction of users who are eligible for a staged release. 0 < fraction < 1. Can only be set when status is \"inProgress\" or \"halted\".",
+			Optional:            true,
+		},
+		"version_codes": schema.ListAttribute{
+			MarkdownDescription: "Version codes of all APKs in the release. Must include version codes to retain from previous releases.",
+			Optional:            true,
+			ElementType:         types.Int64Type,
+		},
+	}
+}
+
+// TrackReleaseDataSourceAttributes returns the data source schema attributes of TrackReleaseModel,
+// which are all computed.
+func TrackReleaseDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"country_targeting": dsschema.SingleNestedAttribute{
+			MarkdownDescription: "Restricts a release to a specific set of countries.",
+			Computed:            true,
+			Attributes:          CountryTargetingDataSourceAttributes(),
+		},
+		"in_app_update_priority": dsschema.Int64Attribute{
+			MarkdownDescription: "In-app update priority of the release. All newly added APKs in the release will be considered at this priority. Can take values in the range [0, 5], with 5 the highest priority. Defaults to 0. in_app_update_priority can not be updated once the release is rolled out. See https://developer.android.com/guide/playcore/in-app-updates.",
+			Computed:            true,
+		},
+		"name": dsschema.StringAttribute{
+			MarkdownDescription: "The release name. Not required to be unique. If not set, the name is generated from the APK's version_name. If the release contains multiple APKs, the name is generated from the date.",
+			Computed:            true,
+		},
+		"release_notes": dsschema.ListNestedAttribute{
+			MarkdownDescription: "A description of what is new in this release.",
+			Computed:            true,
+			NestedObject:        dsschema.NestedAttributeObject{Attributes: LocalizedTextDataSourceAttributes()},
+		},
+		"status": dsschema.StringAttribute{
+			MarkdownDescription: "The status of the release. Possible values are `statusUnspecified`, `draft`, `inProgress`, `halted`, `completed`.",
+			Computed:            true,
+		},
+		"user_fraction": dsschema.Float64Attribute{
+			MarkdownDescription: "Fraction of users who are eligible for a staged release. 0 < fraction < 1. Can only be set when status is \"inProgress\" or \"halted\".",
+			Computed:            true,
+		},
+		"version_codes": dsschema.ListAttribute{
+			MarkdownDescription: "Version codes of all APKs in the release. Must include version codes to retain from previous releases.",
+			Computed:            true,
+			ElementType:         types.Int64Type,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *TrackReleaseModel) FromAPI(ctx context.Context, in *androidpublisher.TrackRelease) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.TrackRelease{}
+	}
+	if in.CountryTargeting != nil {
+		var model CountryTargetingModel
+		diags.Append(model.FromAPI(ctx, in.CountryTargeting)...)
+		value, d := types.ObjectValueFrom(ctx, CountryTargetingAttrTypes, model)
+		diags.Append(d...)
+		m.CountryTargeting = value
+	} else {
+		m.CountryTargeting = types.ObjectNull(CountryTargetingAttrTypes)
+	}
+	m.InAppUpdatePriority = int64FromAPI(in.InAppUpdatePriority, true)
+	m.Name = stringFromAPI(in.Name, true)
+	{
+		models := make([]LocalizedTextModel, 0, len(in.ReleaseNotes))
+		for _, item := range in.ReleaseNotes {
+			if item == nil {
+				continue
+			}
+			var model LocalizedTextModel
+			diags.Append(model.FromAPI(ctx, item)...)
+			models = append(models, model)
+		}
+		value, d := listFromAPI(ctx, types.ObjectType{AttrTypes: LocalizedTextAttrTypes}, models, true)
+		diags.Append(d...)
+		m.ReleaseNotes = value
+	}
+	m.Status = stringFromAPI(in.Status, true)
+	m.UserFraction = float64FromAPI(in.UserFraction, true)
+	{
+		value, d := listFromAPI(ctx, types.Int64Type, in.VersionCodes, true)
+		diags.Append(d...)
+		m.VersionCodes = value
+	}
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m TrackReleaseModel) ToAPI(ctx context.Context) (*androidpublisher.TrackRelease, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.TrackRelease{}
+	{
+		model, d := objectAs[CountryTargetingModel](ctx, m.CountryTargeting)
+		diags.Append(d...)
+		if model != nil {
+			out.CountryTargeting, d = model.ToAPI(ctx)
+			diags.Append(d...)
+		}
+	}
+	out.InAppUpdatePriority = m.InAppUpdatePriority.ValueInt64()
+	out.Name = m.Name.ValueString()
+	{
+		var models []LocalizedTextModel
+		diags.Append(elementsAs(ctx, m.ReleaseNotes, &models)...)
+		for _, model := range models {
+			item, d := model.ToAPI(ctx)
+			diags.Append(d...)
+			out.ReleaseNotes = append(out.ReleaseNotes, item)
+		}
+	}
+	out.Status = m.Status.ValueString()
+	out.UserFraction = m.UserFraction.ValueFloat64()
+	diags.Append(elementsAs(ctx, m.VersionCodes, &out.VersionCodes)...)
+	return out, diags
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// UserModel is the Terraform model of androidpublisher.User. A user resource.
+type UserModel struct {
+	AccessState                 types.String `tfsdk:"access_state"`
+	DeveloperAccountPermissions types.List   `tfsdk:"developer_account_permissions"`
+	Email                       types.String `tfsdk:"email"`
+	ExpirationTime              types.String `tfsdk:"expiration_time"`
+	Grants                      types.List   `tfsdk:"grants"`
+	Name                        types.String `tfsdk:"name"`
+	Partial                     types.Bool   `tfsdk:"partial"`
+}
+
+// UserAttrTypes are the attribute types of UserModel.
+var UserAttrTypes = map[string]attr.Type{
+	"access_state":                  types.StringType,
+	"developer_account_permissions": types.ListType{ElemType: types.StringType},
+	"email":                         types.StringType,
+	"expiration_time":               types.StringType,
+	"grants":                        types.ListType{ElemType: types.ObjectType{AttrTypes: GrantAttrTypes}},
+	"name":                          types.StringType,
+	"partial":                       types.BoolType,
+}
+
+// UserAttributes returns the resource schema attributes of UserModel.
+func UserAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"access_state": schema.StringAttribute{
+			MarkdownDescription: "Output only. The state of the user's access to the Play Console. Possible values are `ACCESS_STATE_UNSPECIFIED`, `INVITED`, `INVITATION_EXPIRED`, `ACCESS_GRANTED`, `ACCESS_EXPIRED`.",
+			Computed:            true,
+		},
+		"developer_account_permissions": schema.ListAttribute{
+			MarkdownDescription: "Permissions for the user which apply across the developer account. Possible values are `DEVELOPER_LEVEL_PERMISSION_UNSPECIFIED`, `CAN_SEE_ALL_APPS`, `CAN_VIEW_FINANCIAL_DATA_GLOBAL`, `CAN_MANAGE_PERMISSIONS_GLOBAL`, `CAN_EDIT_GAMES_GLOBAL`, `CAN_PUBLISH_GAMES_GLOBAL`, `CAN_REPLY_TO_REVIEWS_GLOBAL`, `CAN_MANAGE_PUBLIC_APKS_GLOBAL`, `CAN_MANAGE_TRACK_APKS_GLOBAL`, `CAN_MANAGE_TRACK_USERS_GLOBAL`, `CAN_MANAGE_PUBLIC_LISTING_GLOBAL`, `CAN_MANAGE_DRAFT_APPS_GLOBAL`, `CAN_CREATE_MANAGED_PLAY_APPS_GLOBAL`, `CAN_CHANGE_MANAGED_PLAY_SETTING_GLOBAL`, `CAN_MANAGE_ORDERS_GLOBAL`, `CAN_MANAGE_APP_CONTENT_GLOBAL`, `CAN_VIEW_NON_FINANCIAL_DATA_GLOBAL`, `CAN_VIEW_APP_QUALITY_GLOBAL`, `CAN_MANAGE_DEEPLINKS_GLOBAL`.",
+			Optional:            true,
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"email": schema.StringAttribute{
+			MarkdownDescription: "Immutable. The user's email address.",
+			Required:            true,
+		},
+		"expiration_time": schema.StringAttribute{
+			MarkdownDescription: "The time at which the user's access expires, if set. When setting this value, it must always be in the future.",
+			Optional:            true,
+		},
+		"grants": schema.ListNestedAttribute{
+			MarkdownDescription: "Output only. Per-app permissions for the user.",
+			Computed:            true,
+			NestedObject:        schema.NestedAttributeObject{Attributes: GrantAttributes()},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Required. Resource name for this user, following the pattern \"developers/{developer}/users/{email}\".",
+			Computed:            true,
+		},
+		"partial": schema.BoolAttribute{
+			MarkdownDescription: "Output only. Whether there are more permissions for the user that are not represented here. This can happen if the caller does not have permission to manage all apps in the account. This is also `true` if this user is the account owner. If this field is `true`, it should be taken as a signal that this user cannot be fully managed via the API. That is, the API caller is not be able to manage all of the permissions this user holds, either because it doesn't know about them or because the user is the account owner.",
+			Computed:            true,
+		},
+	}
+}
+
+// UserDataSourceAttributes returns the data source schema attributes of UserModel,
+// which are all computed.
+func UserDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"access_state": dsschema.StringAttribute{
+			MarkdownDescription: "Output only. The state of the user's access to the Play Console. Possible values are `ACCESS_STATE_UNSPECIFIED`, `INVITED`, `INVITATION_EXPIRED`, `ACCESS_GRANTED`, `ACCESS_EXPIRED`.",
+			Computed:            true,
+		},
+		"developer_account_permissions": dsschema.ListAttribute{
+			MarkdownDescription: "Permissions for the user which apply across the developer account. Possible values are `DEVELOPER_LEVEL_PERMISSION_UNSPECIFIED`, `CAN_SEE_ALL_APPS`, `CAN_VIEW_FINANCIAL_DATA_GLOBAL`, `CAN_MANAGE_PERMISSIONS_GLOBAL`, `CAN_EDIT_GAMES_GLOBAL`, `CAN_PUBLISH_GAMES_GLOBAL`, `CAN_REPLY_TO_REVIEWS_GLOBAL`, `CAN_MANAGE_PUBLIC_APKS_GLOBAL`, `CAN_MANAGE_TRACK_APKS_GLOBAL`, `CAN_MANAGE_TRACK_USERS_GLOBAL`, `CAN_MANAGE_PUBLIC_LISTING_GLOBAL`, `CAN_MANAGE_DRAFT_APPS_GLOBAL`, `CAN_CREATE_MANAGED_PLAY_APPS_GLOBAL`, `CAN_CHANGE_MANAGED_PLAY_SETTING_GLOBAL`, `CAN_MANAGE_ORDERS_GLOBAL`, `CAN_MANAGE_APP_CONTENT_GLOBAL`, `CAN_VIEW_NON_FINANCIAL_DATA_GLOBAL`, `CAN_VIEW_APP_QUALITY_GLOBAL`, `CAN_MANAGE_DEEPLINKS_GLOBAL`.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"email": dsschema.StringAttribute{
+			MarkdownDescription: "Immutable. The user's email address.",
+			Computed:            true,
+		},
+		"expiration_time": dsschema.StringAttribute{
+			MarkdownDescription: "The time at which the user's access expires, if set. When setting this value, it must always be in the future.",
+			Computed:            true,
+		},
+		"grants": dsschema.ListNestedAttribute{
+			MarkdownDescription: "Output only. Per-app permissions for the user.",
+			Computed:            true,
+			NestedObject:        dsschema.NestedAttributeObject{Attributes: GrantDataSourceAttributes()},
+		},
+		"name": dsschema.StringAttribute{
+			MarkdownDescription: "Required. Resource name for this user, following the pattern \"developers/{developer}/users/{email}\".",
+			Computed:            true,
+		},
+		"partial": dsschema.BoolAttribute{
+			MarkdownDescription: "Output only. Whether there are more permissions for the user that are not represented here. This can happen if the caller does not have permission to manage all apps in the account. This is also `true` if this user is the account owner. If this field is `true`, it should be taken as a signal that this user cannot be fully managed via the API. That is, the API caller is not be able to manage all of the permissions this user holds, either because it doesn't know about them or because the user is the account owner.",
+			Computed:            true,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *UserModel) FromAPI(ctx context.Context, in *androidpublisher.User) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.User{}
+	}
+	m.AccessState = stringFromAPI(in.AccessState, false)
+	{
+		value, d := listFromAPI(ctx, types.StringType, in.DeveloperAccountPermissions, false)
+		diags.Append(d...)
+		m.DeveloperAccountPermissions = value
+	}
+	m.Email = stringFromAPI(in.Email, false)
+	m.ExpirationTime = stringFromAPI(in.ExpirationTime, true)
+	{
+		models := make([]GrantModel, 0, len(in.Grants))
+		for _, item := range in.Grants {
+			if item == nil {
+				continue
+			}
+			var model GrantModel
+			diags.Append(model.FromAPI(ctx, item)...)
+			models = append(models, model)
+		}
+		value, d := listFromAPI(ctx, types.ObjectType{AttrTypes: GrantAttrTypes}, models, false)
+		diags.Append(d...)
+		m.Grants = value
+	}
+	m.Name = stringFromAPI(in.Name, false)
+	m.Partial = boolFromAPI(in.Partial, false)
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m UserModel) ToAPI(ctx context.Context) (*androidpublisher.User, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.User{}
+	out.AccessState = m.AccessState.ValueString()
+	diags.Append(elementsAs(ctx, m.DeveloperAccountPermissions, &out.DeveloperAccountPermissions)...)
+	out.Email = m.Email.ValueString()
+	out.ExpirationTime = m.ExpirationTime.ValueString()
+	{
+		var models []GrantModel
+		diags.Append(elementsAs(ctx, m.Grants, &models)...)
+		for _, model := range models {
+			item, d := model.ToAPI(ctx)
+			diags.Append(d...)
+			out.Grants = append(out.Grants, item)
+		}
+	}
+	out.Name = m.Name.ValueString()
+	out.Partial = m.Partial.ValueBool()
+	return out, diags
+}
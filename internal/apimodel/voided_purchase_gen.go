@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by schemagen from the androidpublisher v3 discovery document, revision 20241016. DO NOT EDIT.
+
+package apimodel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// VoidedPurchaseModel is the Terraform model of
+// androidpublisher.VoidedPurchase. A VoidedPurchase resource indicates a
+// purchase that was either canceled/refunded/charged-back.
+type VoidedPurchaseModel struct {
+	Kind               types.String `tfsdk:"kind"`
+	OrderId            types.String `tfsdk:"order_id"`
+	PurchaseTimeMillis types.Int64  `tfsdk:"purchase_time_millis"`
+	PurchaseToken      types.String `tfsdk:"purchase_token"`
+	VoidedQuantity     types.Int64  `tfsdk:"voided_quantity"`
+	VoidedReason       types.Int64  `tfsdk:"voided_reason"`
+	VoidedSource       types.Int64  `tfsdk:"voided_source"`
+	VoidedTimeMillis   types.Int64  `tfsdk:"voided_time_millis"`
+}
+
+// VoidedPurchaseAttrTypes are the attribute types of VoidedPurchaseModel.
+var VoidedPurchaseAttrTypes = map[string]attr.Type{
+	"kind":                 types.StringType,
+	"order_id":             types.StringType,
+	"purchase_time_millis": types.Int64Type,
+	"purchase_token":       types.StringType,
+	"voided_quantity":      types.Int64Type,
+	"voided_reason":        types.Int64Type,
+	"voided_source":        types.Int64Type,
+	"voided_time_millis":   types.Int64Type,
+}
+
+// VoidedPurchaseAttributes returns the resource schema attributes of VoidedPurchaseModel.
+func VoidedPurchaseAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"kind": schema.StringAttribute{
+			MarkdownDescription: "This kind represents a voided purchase object in the androidpublisher service.",
+			Optional:            true,
+		},
+		"order_id": schema.StringAttribute{
+			MarkdownDescription: "The order id which uniquely identifies a one-time purchase, subscription purchase, or subscription renewal.",
+			Optional:            true,
+		},
+		"purchase_time_millis": schema.Int64Attribute{
+			MarkdownDescription: "The time at which the purchase was made, in milliseconds since the epoch (Jan 1, 1970).",
+			Optional:            true,
+		},
+		"purchase_token": schema.StringAttribute{
+			MarkdownDescription: "The token which uniquely identifies a one-time purchase or subscription. To uniquely identify subscription renewals use order_id (available starting from version 3 of the API).",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"voided_quantity": schema.Int64Attribute{
+			MarkdownDescription: "The voided quantity as the result of a quantity-based partial refund. Voided purchases of quantity-based partial refunds may only be returned when includeQuantityBasedPartialRefund is set to true.",
+			Optional:            true,
+		},
+		"voided_reason": schema.Int64Attribute{
+			MarkdownDescription: "The reason why the purchase was voided, possible values are: 0. Other 1. Remorse 2. Not_received 3. Defective 4. Accidental_purchase 5. Fraud 6. Friendly_fraud 7. Chargeback 8. Unacknowledged_purchase",
+			Optional:            true,
+		},
+		"voided_source": schema.Int64Attribute{
+			MarkdownDescription: "The initiator of voided purchase, possible values are: 0. User 1. Developer 2. Google",
+			Optional:            true,
+		},
+		"voided_time_millis": schema.Int64Attribute{
+			MarkdownDescription: "The time at which the purchase was canceled/refunded/charged-back, in milliseconds since the epoch (Jan 1, 1970).",
+			Optional:            true,
+		},
+	}
+}
+
+// VoidedPurchaseDataSourceAttributes returns the data source schema attributes of VoidedPurchaseModel,
+// which are all computed.
+func VoidedPurchaseDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"kind": dsschema.StringAttribute{
+			MarkdownDescription: "This kind represents a voided purchase object in the androidpublisher service.",
+			Computed:            true,
+		},
+		"order_id": dsschema.StringAttribute{
+			MarkdownDescription: "The order id which uniquely identifies a one-time purchase, subscription purchase, or subscription renewal.",
+			Computed:            true,
+		},
+		"purchase_time_millis": dsschema.Int64Attribute{
+			MarkdownDescription: "The time at which the purchase was made, in milliseconds since the epoch (Jan 1, 1970).",
+			Computed:            true,
+		},
+		"purchase_token": dsschema.StringAttribute{
+			MarkdownDescription: "The token which uniquely identifies a one-time purchase or subscription. To uniquely identify subscription renewals use order_id (available starting from version 3 of the API).",
+			Computed:            true,
+			Sensitive:           true,
+		},
+		"voided_quantity": dsschema.Int64Attribute{
+			MarkdownDescription: "The voided quantity as the result of a quantity-based partial refund. Voided purchases of quantity-based partial refunds may only be returned when includeQuantityBasedPartialRefund is set to true.",
+			Computed:            true,
+		},
+		"voided_reason": dsschema.Int64Attribute{
+			MarkdownDescription: "The reason why the purchase was voided, possible values are: 0. Other 1. Remorse 2. Not_received 3. Defective 4. Accidental_purchase 5. Fraud 6. Friendly_fraud 7. Chargeback 8. Unacknowledged_purchase",
+			Computed:            true,
+		},
+		"voided_source": dsschema.Int64Attribute{
+			MarkdownDescription: "The initiator of voided purchase, possible values are: 0. User 1. Developer 2. Google",
+			Computed:            true,
+		},
+		"voided_time_millis": dsschema.Int64Attribute{
+			MarkdownDescription: "The time at which the purchase was canceled/refunded/charged-back, in milliseconds since the epoch (Jan 1, 1970).",
+			Computed:            true,
+		},
+	}
+}
+
+// FromAPI sets the model from an API object. Zero values the API omits
+// are null unless the attribute is required or computed.
+func (m *VoidedPurchaseModel) FromAPI(ctx context.Context, in *androidpublisher.VoidedPurchase) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in == nil {
+		in = &androidpublisher.VoidedPurchase{}
+	}
+	m.Kind = stringFromAPI(in.Kind, true)
+	m.OrderId = stringFromAPI(in.OrderId, true)
+	m.PurchaseTimeMillis = int64FromAPI(in.PurchaseTimeMillis, true)
+	m.PurchaseToken = stringFromAPI(in.PurchaseToken, true)
+	m.VoidedQuantity = int64FromAPI(in.VoidedQuantity, true)
+	m.VoidedReason = int64FromAPI(in.VoidedReason, true)
+	m.VoidedSource = int64FromAPI(in.VoidedSource, true)
+	m.VoidedTimeMillis = int64FromAPI(in.VoidedTimeMillis, true)
+	return diags
+}
+
+// ToAPI returns the API object of the model. Null and unknown values are
+// left empty.
+func (m VoidedPurchaseModel) ToAPI(ctx context.Context) (*androidpublisher.VoidedPurchase, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &androidpublisher.VoidedPurchase{}
+	out.Kind = m.Kind.ValueString()
+	out.OrderId = m.OrderId.ValueString()
+	out.PurchaseTimeMillis = m.PurchaseTimeMillis.ValueInt64()
+	out.PurchaseToken = m.PurchaseToken.ValueString()
+	out.VoidedQuantity = m.VoidedQuantity.ValueInt64()
+	out.VoidedReason = m.VoidedReason.ValueInt64()
+	out.VoidedSource = m.VoidedSource.ValueInt64()
+	out.VoidedTimeMillis = m.VoidedTimeMillis.ValueInt64()
+	return out, diags
+}
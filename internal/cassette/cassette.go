@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package cassette records the HTTP traffic of the provider to files and
+// replays it, so that acceptance tests recorded against the real API can run
+// offline. Recorded traffic is sanitized: headers are dropped, and emails,
+// developer IDs, package names and tokens are replaced with placeholders.
+//
+// A replay matches every request strictly, on its method, sanitized URL and
+// sanitized body, and every recorded interaction must be used exactly once.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Mode is what the acceptance tests do with cassettes.
+type Mode string
+
+const (
+	// ModeOff runs the tests without cassettes.
+	ModeOff Mode = ""
+	// ModeRecord calls the real API and records its traffic.
+	ModeRecord Mode = "record"
+	// ModeReplay serves recorded traffic without calling the API.
+	ModeReplay Mode = "replay"
+)
+
+// ParseMode parses the value of a mode setting.
+func ParseMode(value string) (Mode, error) {
+	switch mode := Mode(value); mode {
+	case ModeOff, ModeRecord, ModeReplay:
+		return mode, nil
+	}
+	return ModeOff, fmt.Errorf("unknown cassette mode %q, expected %q or %q", value, ModeRecord, ModeReplay)
+}
+
+// Cassette is the recorded traffic of a test.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a request and the response the API sent for it.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a sanitized request. URL holds the path and query only.
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// Response is a sanitized response.
+type Response struct {
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        string `json:"body,omitempty"`
+}
+
+// Load reads a cassette file.
+func Load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("unable to parse cassette %s: %w", path, err)
+	}
+	return &cassette, nil
+}
+
+// Save writes the cassette to a file, creating its directory if needed.
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// timestampPattern matches the RFC 3339 timestamps the API uses.
+var timestampPattern = regexp.MustCompile(`\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(?:\.\d+)?Z`)
+
+// readBody reads and replaces the body of a request or response.
+func readBody(body *io.ReadCloser) (string, error) {
+	if *body == nil || *body == http.NoBody {
+		return "", nil
+	}
+	data, err := io.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return "", err
+	}
+	*body = io.NopCloser(bytes.NewReader(data))
+	return string(data), nil
+}
+
+// sanitizeRequest reads and sanitizes a request. The request body is
+// replaced so that it can still be sent.
+func sanitizeRequest(sanitizer *Sanitizer, req *http.Request) (Request, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return Request{}, err
+	}
+	return Request{
+		Method: req.Method,
+		URL:    sanitizer.SanitizeURL(req.URL),
+		Body:   sanitizer.Sanitize(body),
+	}, nil
+}
+
+// Recorder records the traffic of the transports it wraps.
+type Recorder struct {
+	sanitizer *Sanitizer
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder returns a recorder that sanitizes traffic with the sanitizer.
+func NewRecorder(sanitizer *Sanitizer) *Recorder {
+	return &Recorder{sanitizer: sanitizer}
+}
+
+// Wrap returns a transport that sends requests through next and records
+// them. Wrap can be called several times, for example once per provider
+// instance, to record into the same cassette.
+func (r *Recorder) Wrap(next http.RoundTripper) http.RoundTripper {
+	return recordingTransport{recorder: r, next: next}
+}
+
+// Save writes the recorded interactions to a cassette file.
+func (r *Recorder) Save(path string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cassette.Save(path)
+}
+
+type recordingTransport struct {
+	recorder *Recorder
+	next     http.RoundTripper
+}
+
+func (t recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	request, err := sanitizeRequest(t.recorder.sanitizer, req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	t.recorder.mu.Lock()
+	defer t.recorder.mu.Unlock()
+	t.recorder.cassette.Interactions = append(t.recorder.cassette.Interactions, Interaction{
+		Request: request,
+		Response: Response{
+			StatusCode:  resp.StatusCode,
+			ContentType: resp.Header.Get("Content-Type"),
+			Body:        t.recorder.sanitizer.Sanitize(body),
+		},
+	})
+	return resp, nil
+}
+
+// Replayer serves the interactions of a cassette. Each request is served
+// the response of the first unused interaction that matches it, which keeps
+// replays stable when Terraform sends requests concurrently.
+//
+// Timestamps in request bodies, such as expiration times computed from the
+// current time, are matched as placeholders. The recorded timestamps are
+// then replaced with the ones the tests sent in every response served.
+type Replayer struct {
+	sanitizer *Sanitizer
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+	// timestamps maps recorded timestamps to the ones sent in their place.
+	timestamps map[string]string
+}
+
+// NewReplayer returns a replayer of the cassette. Requests are sanitized
+// with the sanitizer before they are matched.
+func NewReplayer(cassette *Cassette, sanitizer *Sanitizer) *Replayer {
+	return &Replayer{
+		sanitizer:    sanitizer,
+		interactions: cassette.Interactions,
+		used:         make([]bool, len(cassette.Interactions)),
+		timestamps:   make(map[string]string),
+	}
+}
+
+// Wrap returns the replayer, ignoring next, so that it can be used where a
+// transport wrapper is expected.
+func (r *Replayer) Wrap(next http.RoundTripper) http.RoundTripper {
+	return r
+}
+
+// Unused returns the recorded requests that were never sent.
+func (r *Replayer) Unused() []Request {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unused []Request
+	for i, interaction := range r.interactions {
+		if !r.used[i] {
+			unused = append(unused, interaction.Request)
+		}
+	}
+	return unused
+}
+
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	request, err := sanitizeRequest(r.sanitizer, req)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.interactions {
+		if r.used[i] || !matches(interaction.Request, request) {
+			continue
+		}
+		r.used[i] = true
+
+		recorded := timestampPattern.FindAllString(interaction.Request.Body, -1)
+		sent := timestampPattern.FindAllString(request.Body, -1)
+		for j := range recorded {
+			r.timestamps[recorded[j]] = sent[j]
+		}
+		body := timestampPattern.ReplaceAllStringFunc(interaction.Response.Body, func(timestamp string) string {
+			if replacement, ok := r.timestamps[timestamp]; ok {
+				return replacement
+			}
+			return timestamp
+		})
+
+		header := make(http.Header)
+		if interaction.Response.ContentType != "" {
+			header.Set("Content-Type", interaction.Response.ContentType)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, &MismatchError{Request: request}
+}
+
+// MismatchError is returned for a request that matches no unused interaction.
+type MismatchError struct {
+	Request Request
+}
+
+func (e *MismatchError) Error() string {
+	return fmt.Sprintf("no recorded interaction matches %s %s with body %q", e.Request.Method, e.Request.URL, e.Request.Body)
+}
+
+// matches reports whether a sanitized request matches a recorded one.
+func matches(recorded, request Request) bool {
+	return recorded.Method == request.Method &&
+		recorded.URL == request.URL &&
+		normalizeBody(recorded.Body) == normalizeBody(request.Body)
+}
+
+// normalizeBody replaces timestamps and compacts JSON, so that bodies which
+// only differ in formatting or in the time they were sent match.
+func normalizeBody(body string) string {
+	body = timestampPattern.ReplaceAllString(body, "<timestamp>")
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, []byte(body)); err != nil {
+		return body
+	}
+	return compacted.String()
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cassette
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSanitize(t *testing.T) {
+	s := NewSanitizer(map[string]string{
+		"me@corp.com":    "tester@example.com",
+		"555":            "1234567891234567891",
+		"com.corp.thing": "com.example.app",
+	})
+
+	// The cases share the sanitizer, so later cases see the placeholders
+	// given out by earlier ones.
+	tests := []struct {
+		name, text, expected string
+	}{
+		{
+			name:     "known values",
+			text:     `{"name": "developers/555/users/me@corp.com/grants/com.corp.thing"}`,
+			expected: `{"name": "developers/1234567891234567891/users/tester@example.com/grants/com.example.app"}`,
+		},
+		{
+			name:     "other values",
+			text:     `{"email": "boss@corp.com", "name": "developers/777/users/boss@corp.com", "grants": [{"packageName": "com.corp.other"}]}`,
+			expected: `{"email": "user1@example.com", "name": "developers/1000000000000000001/users/user1@example.com", "grants": [{"packageName": "com.example.app1"}]}`,
+		},
+		{
+			name:     "repeated values keep their placeholders",
+			text:     `{"name": "developers/777/users/admin@corp.com/grants/com.corp.other", "email": "boss@corp.com"}`,
+			expected: `{"name": "developers/1000000000000000001/users/user2@example.com/grants/com.example.app1", "email": "user1@example.com"}`,
+		},
+		{
+			name:     "tokens",
+			text:     `{"nextPageToken": "abc", "access_token": "ya29.secret"}`,
+			expected: `{"nextPageToken": "page-token-1", "access_token": "redacted-1"}`,
+		},
+		{
+			name:     "placeholders",
+			text:     `{"name": "developers/1000000000000000009/users/user9@example.com/grants/com.example.app9", "nextPageToken": "page-token-9"}`,
+			expected: `{"name": "developers/1000000000000000009/users/user9@example.com/grants/com.example.app9", "nextPageToken": "page-token-9"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.Sanitize(tt.text); got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+
+	u, err := url.Parse("https://example.com/androidpublisher/v3/developers/555/users?pageSize=10&pageToken=abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "/androidpublisher/v3/developers/1234567891234567891/users?pageSize=10&pageToken=page-token-1"
+	if got := s.SanitizeURL(u); got != expected {
+		t.Errorf("expected the page token to get the placeholder of the nextPageToken, got %s", got)
+	}
+}
+
+// newAPI returns a server that answers every authenticated request with a
+// user and the request body.
+func newAPI(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"email": "me@corp.com", "lastChange": "2024-01-01T00:00:00Z", "request": `+string(body)+`}`)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// authenticated adds the credentials the fake API expects.
+type authenticated struct{}
+
+func (authenticated) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer secret")
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func send(t *testing.T, client *http.Client, target, body string) (string, error) {
+	t.Helper()
+	resp, err := client.Post(target, "application/json", strings.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data), nil
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	server := newAPI(t)
+	path := filepath.Join(t.TempDir(), "test.json")
+	target := server.URL + "/developers/555/users"
+
+	recorder := NewRecorder(NewSanitizer(map[string]string{"me@corp.com": "tester@example.com", "555": "123"}))
+	recording := &http.Client{Transport: recorder.Wrap(authenticated{})}
+	if _, err := send(t, recording, target, `{"email": "me@corp.com", "expirationTime": "2030-01-01T00:00:00Z"}`); err != nil {
+		t.Fatal(err)
+	}
+	if err := recorder.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	recorded, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recorded.Interactions) != 1 {
+		t.Fatalf("expected one interaction, got %+v", recorded.Interactions)
+	}
+	interaction := recorded.Interactions[0]
+	if interaction.Request.URL != "/developers/123/users" || strings.Contains(interaction.Request.Body+interaction.Response.Body, "corp.com") {
+		t.Errorf("expected the interaction to be sanitized, got %+v", interaction)
+	}
+
+	replayer := NewReplayer(recorded, NewSanitizer(map[string]string{"tester@example.com": "tester@example.com", "123": "123"}))
+	replaying := &http.Client{Transport: replayer.Wrap(nil)}
+	// The expiration time differs from the recorded one, as it would when
+	// computed from the current time.
+	body, err := send(t, replaying, "https://androidpublisher.googleapis.com/developers/123/users", `{"email":"tester@example.com","expirationTime":"2031-06-01T00:00:00Z"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"email": "tester@example.com", "lastChange": "2024-01-01T00:00:00Z", "request": {"email": "tester@example.com", "expirationTime": "2031-06-01T00:00:00Z"}}`
+	if body != expected {
+		t.Errorf("expected %s, got %s", expected, body)
+	}
+	if unused := replayer.Unused(); len(unused) != 0 {
+		t.Errorf("expected no unused interactions, got %+v", unused)
+	}
+
+	// Every interaction is replayed once.
+	_, err = send(t, replaying, "https://androidpublisher.googleapis.com/developers/123/users", `{"email":"tester@example.com","expirationTime":"2031-06-01T00:00:00Z"}`)
+	var mismatch *MismatchError
+	if !errors.As(err, &mismatch) {
+		t.Errorf("expected a mismatch error, got %v", err)
+	}
+}
+
+func TestReplayMatchesStrictly(t *testing.T) {
+	recorded := &Cassette{Interactions: []Interaction{{
+		Request:  Request{Method: http.MethodPost, URL: "/developers/123/users", Body: `{"email": "user1@example.com"}`},
+		Response: Response{StatusCode: http.StatusOK, Body: `{}`},
+	}}}
+
+	tests := map[string]struct {
+		method, target, body string
+	}{
+		"method": {http.MethodPatch, "/developers/123/users", `{"email": "user1@example.com"}`},
+		"path":   {http.MethodPost, "/developers/123/users/user1@example.com", `{"email": "user1@example.com"}`},
+		"query":  {http.MethodPost, "/developers/123/users?updateMask=email", `{"email": "user1@example.com"}`},
+		"body":   {http.MethodPost, "/developers/123/users", `{"email": "user2@example.com"}`},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			replayer := NewReplayer(recorded, NewSanitizer(nil))
+			req := httptest.NewRequest(tt.method, "https://androidpublisher.googleapis.com"+tt.target, strings.NewReader(tt.body))
+			var mismatch *MismatchError
+			if _, err := replayer.RoundTrip(req); !errors.As(err, &mismatch) {
+				t.Errorf("expected a mismatch error, got %v", err)
+			}
+			if unused := replayer.Unused(); len(unused) != 1 {
+				t.Errorf("expected the interaction to stay unused, got %+v", unused)
+			}
+		})
+	}
+}
+
+func TestParseMode(t *testing.T) {
+	for _, value := range []string{"", "record", "replay"} {
+		if mode, err := ParseMode(value); err != nil || string(mode) != value {
+			t.Errorf("expected %q to parse, got %q, %v", value, mode, err)
+		}
+	}
+	if _, err := ParseMode("rewind"); err == nil {
+		t.Error("expected an unknown mode to be rejected")
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cassette
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// pageTokenParameter is the query parameter that requests a page of a listing.
+const pageTokenParameter = "pageToken"
+
+// scrubber replaces the values captured by the first group of its patterns
+// with numbered placeholders, so that the same value always gets the same
+// placeholder.
+type scrubber struct {
+	patterns []*regexp.Regexp
+	// format formats the placeholder of the n-th value.
+	format string
+	// placeholder matches values that are already placeholders.
+	placeholder *regexp.Regexp
+	values      map[string]string
+}
+
+func newScrubber(format, placeholder string, patterns ...string) *scrubber {
+	s := &scrubber{
+		format:      format,
+		placeholder: regexp.MustCompile(placeholder),
+		values:      make(map[string]string),
+	}
+	for _, pattern := range patterns {
+		s.patterns = append(s.patterns, regexp.MustCompile(pattern))
+	}
+	return s
+}
+
+func (s *scrubber) value(value string, known map[string]bool) string {
+	if known[value] || s.placeholder.MatchString(value) {
+		return value
+	}
+	if placeholder, ok := s.values[value]; ok {
+		return placeholder
+	}
+	placeholder := fmt.Sprintf(s.format, len(s.values)+1)
+	s.values[value] = placeholder
+	return placeholder
+}
+
+func (s *scrubber) scrub(text string, known map[string]bool) string {
+	for _, pattern := range s.patterns {
+		var b strings.Builder
+		last := 0
+		for _, match := range pattern.FindAllStringSubmatchIndex(text, -1) {
+			b.WriteString(text[last:match[2]])
+			b.WriteString(s.value(text[match[2]:match[3]], known))
+			last = match[3]
+		}
+		b.WriteString(text[last:])
+		text = b.String()
+	}
+	return text
+}
+
+// Sanitizer scrubs emails, developer IDs, package names and page tokens
+// from recorded traffic. Known values, such as the email the tests invite,
+// are replaced with fixed placeholders so that the tests can use the same
+// placeholders when the cassette is replayed. Any other value is replaced
+// with a numbered placeholder. Placeholders are left untouched, so
+// sanitizing a sanitized request does not change it.
+//
+// Headers are never recorded, so credentials do not need to be scrubbed.
+type Sanitizer struct {
+	mu       sync.Mutex
+	replacer *strings.Replacer
+	known    map[string]bool
+
+	emails      *scrubber
+	developers  *scrubber
+	packages    *scrubber
+	pageTokens  *scrubber
+	tokenFields *scrubber
+}
+
+// NewSanitizer returns a sanitizer that replaces the keys of replacements
+// with their values before scrubbing anything else.
+func NewSanitizer(replacements map[string]string) *Sanitizer {
+	// Replace longer values first, in case one contains another.
+	keys := make([]string, 0, len(replacements))
+	for key := range replacements {
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+	var oldnew []string
+	known := make(map[string]bool, len(replacements))
+	for _, key := range keys {
+		oldnew = append(oldnew, key, replacements[key])
+		known[replacements[key]] = true
+	}
+
+	return &Sanitizer{
+		replacer:    strings.NewReplacer(oldnew...),
+		known:       known,
+		emails:      newScrubber("user%d@example.com", `^user\d+@example\.com$`, `([A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,})`),
+		developers:  newScrubber("100000000000000%04d", `^100000000000000\d{4}$`, `developers/(\d+)`),
+		packages:    newScrubber("com.example.app%d", `^com\.example\.app\d+$`, `"packageName":\s*"([^"]+)"`, `/grants/([^/?"]+)`),
+		pageTokens:  newScrubber("page-token-%d", `^page-token-\d+$`, `"nextPageToken":\s*"([^"]+)"`),
+		tokenFields: newScrubber("redacted-%d", `^redacted-\d+$`, `"(?:access_token|refresh_token|id_token)":\s*"([^"]+)"`),
+	}
+}
+
+// Sanitize scrubs a request or response body, or a URL path.
+func (s *Sanitizer) Sanitize(text string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sanitize(text)
+}
+
+func (s *Sanitizer) sanitize(text string) string {
+	text = s.replacer.Replace(text)
+	text = s.emails.scrub(text, s.known)
+	text = s.developers.scrub(text, s.known)
+	text = s.packages.scrub(text, s.known)
+	text = s.pageTokens.scrub(text, s.known)
+	return s.tokenFields.scrub(text, s.known)
+}
+
+// SanitizeURL returns the scrubbed path and query of a request URL. Page
+// tokens in the query get the placeholders of the nextPageToken they came
+// from.
+func (s *Sanitizer) SanitizeURL(u *url.URL) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := s.sanitize(u.Path)
+	query := u.Query()
+	if len(query) == 0 {
+		return path
+	}
+	for key, values := range query {
+		for i, value := range values {
+			if key == pageTokenParameter {
+				values[i] = s.pageTokens.value(value, s.known)
+			} else {
+				values[i] = s.sanitize(value)
+			}
+		}
+	}
+	return path + "?" + query.Encode()
+}
@@ -0,0 +1,629 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fakeplay is an in-memory implementation of the parts of the Google
+// Play Developer API the provider uses: users, grants and edits. It serves
+// the same paths, pagination and error responses as the real API so that
+// the provider can be tested without a developer account.
+package fakeplay
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/names"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/permissions"
+	"google.golang.org/api/androidpublisher/v3"
+	"google.golang.org/api/option"
+)
+
+// basePath is the path prefix of every API call.
+const basePath = "/androidpublisher/v3/"
+
+// DefaultMaxPageSize is the largest page served by a listing unless
+// Server.MaxPageSize is set. It is smaller than the page size the provider
+// requests, so listings with more users are split into several pages.
+const DefaultMaxPageSize = 20
+
+// editLifetime is how long an edit stays open after it is inserted.
+const editLifetime = time.Hour
+
+// Access states the server reports for users.
+const (
+	accessStateInvited = "INVITED"
+	accessStateGranted = "ACCESS_GRANTED"
+)
+
+// ClientOptions returns the options that point an API client at a fake
+// server listening on the endpoint, such as an httptest.Server URL.
+func ClientOptions(endpoint string) []option.ClientOption {
+	return []option.ClientOption{
+		option.WithEndpoint(endpoint),
+		option.WithoutAuthentication(),
+	}
+}
+
+// Request is a call received by the server.
+type Request struct {
+	Method string
+	// Name is the path of the call below the API base path, such as
+	// "developers/123/users".
+	Name string
+	// UpdateMask is the updateMask parameter of patches.
+	UpdateMask string
+	// PageToken is the pageToken parameter of listings.
+	PageToken string
+	// Body is the decoded JSON body, or nil if the call has none.
+	Body map[string]interface{}
+}
+
+// Server is an http.Handler serving the fake API. The zero value is not
+// usable, create servers with New.
+type Server struct {
+	// MaxPageSize caps the page size of listings. Zero means DefaultMaxPageSize.
+	MaxPageSize int
+	// Now returns the current time, used for edit expiry.
+	Now func() time.Time
+	// OnList, if set, is called with every user of a developer account
+	// before every page of a listing is served, so that tests can change users
+	// over time, such as accepting an invitation. It runs with the server
+	// locked.
+	OnList func(user *androidpublisher.User)
+
+	mu       sync.Mutex
+	requests []Request
+	// users is keyed by resource name.
+	users map[string]*androidpublisher.User
+	// apps are the package names that exist.
+	apps map[string]bool
+	// edits is keyed by resource name.
+	edits      map[string]*androidpublisher.AppEdit
+	nextEditID int64
+}
+
+// New returns a server without users or apps.
+func New() *Server {
+	return &Server{
+		Now:   time.Now,
+		users: make(map[string]*androidpublisher.User),
+		apps:  make(map[string]bool),
+		edits: make(map[string]*androidpublisher.AppEdit),
+	}
+}
+
+// AddApp registers an app so that grants and edits can refer to it.
+func (s *Server) AddApp(packageName string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.apps[packageName] = true
+}
+
+// PutUser adds or replaces a user of the developer account, such as an
+// account owner that exists before a test starts. Name is set from the
+// developer ID and email.
+func (s *Server) PutUser(developerID string, user *androidpublisher.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored := *user
+	stored.Name = names.User{DeveloperID: developerID, Email: user.Email}.String()
+	for _, g := range stored.Grants {
+		g.Name = names.Grant{DeveloperID: developerID, Email: user.Email, PackageName: g.PackageName}.String()
+	}
+	s.users[stored.Name] = &stored
+}
+
+// SeedAccount adds an account owner with every developer account permission,
+// as every real developer account has one. The owner is reported as a
+// partial user, like the real API does for the account owner.
+func (s *Server) SeedAccount(developerID string, ownerEmail string) {
+	s.PutUser(developerID, &androidpublisher.User{
+		Email:                       ownerEmail,
+		AccessState:                 accessStateGranted,
+		DeveloperAccountPermissions: slices.Clone(permissions.DeveloperAccountPermissions),
+		Partial:                     true,
+	})
+}
+
+// Users returns copies of the users of the developer account, sorted by email.
+func (s *Server) Users(developerID string) []*androidpublisher.User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var result []*androidpublisher.User
+	for _, user := range s.listUsers(developerID) {
+		copied := *user
+		result = append(result, &copied)
+	}
+	return result
+}
+
+// Requests returns the calls received so far, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.requests)
+}
+
+// Edits returns the names of the open edits.
+func (s *Server) Edits() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var result []string
+	for name := range s.edits {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (s *Server) listUsers(developerID string) []*androidpublisher.User {
+	prefix := names.Developer{ID: developerID}.String() + "/users/"
+	var result []*androidpublisher.User
+	for name, user := range s.users {
+		if strings.HasPrefix(name, prefix) {
+			result = append(result, user)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Email < result[j].Email })
+	return result
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !strings.HasPrefix(r.URL.Path, basePath) {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("Unknown path %q.", r.URL.Path))
+		return
+	}
+	if !s.record(w, r) {
+		return
+	}
+	name, method, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, basePath), ":")
+
+	switch {
+	case strings.HasSuffix(name, "/users"):
+		developer, err := names.ParseDeveloper(strings.TrimSuffix(name, "/users"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", err.Error())
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			s.handleListUsers(w, r, developer)
+		case http.MethodPost:
+			s.handleCreateUser(w, r, developer)
+		default:
+			writeMethodNotAllowed(w, r)
+		}
+	case strings.HasSuffix(name, "/grants"):
+		user, err := names.ParseUser(strings.TrimSuffix(name, "/grants"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", err.Error())
+			return
+		}
+		if r.Method != http.MethodPost {
+			writeMethodNotAllowed(w, r)
+			return
+		}
+		s.handleCreateGrant(w, r, user)
+	case strings.HasSuffix(name, "/edits"):
+		app, err := names.ParseApp(strings.TrimSuffix(name, "/edits"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", err.Error())
+			return
+		}
+		if r.Method != http.MethodPost {
+			writeMethodNotAllowed(w, r)
+			return
+		}
+		s.handleInsertEdit(w, app)
+	case strings.HasPrefix(name, "developers/") && strings.Contains(name, "/grants/"):
+		g, err := names.ParseGrant(name)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", err.Error())
+			return
+		}
+		switch r.Method {
+		case http.MethodPatch:
+			s.handlePatchGrant(w, r, g)
+		case http.MethodDelete:
+			s.handleDeleteGrant(w, g)
+		default:
+			writeMethodNotAllowed(w, r)
+		}
+	case strings.HasPrefix(name, "developers/"):
+		user, err := names.ParseUser(name)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", err.Error())
+			return
+		}
+		switch r.Method {
+		case http.MethodPatch:
+			s.handlePatchUser(w, r, user)
+		case http.MethodDelete:
+			s.handleDeleteUser(w, user)
+		default:
+			writeMethodNotAllowed(w, r)
+		}
+	case strings.HasPrefix(name, "applications/"):
+		edit, err := names.ParseEdit(name)
+		if err != nil {
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+			return
+		}
+		switch {
+		case r.Method == http.MethodGet && method == "":
+			s.handleGetEdit(w, edit)
+		case r.Method == http.MethodDelete && method == "":
+			s.handleDeleteEdit(w, edit)
+		case r.Method == http.MethodPost && method == "validate":
+			s.handleValidateEdit(w, edit)
+		case r.Method == http.MethodPost && method == "commit":
+			s.handleCommitEdit(w, edit)
+		default:
+			writeMethodNotAllowed(w, r)
+		}
+	default:
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("Unknown resource %q.", name))
+	}
+}
+
+// record appends the call to the requests, keeping its body readable by the
+// handlers. It writes an error if the body is not a JSON object.
+func (s *Server) record(w http.ResponseWriter, r *http.Request) bool {
+	request := Request{
+		Method:     r.Method,
+		Name:       strings.TrimPrefix(r.URL.Path, basePath),
+		UpdateMask: r.URL.Query().Get("updateMask"),
+		PageToken:  r.URL.Query().Get("pageToken"),
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", fmt.Sprintf("Unable to read body: %v.", err))
+		return false
+	}
+	if len(bytes.TrimSpace(body)) > 0 {
+		if err := json.Unmarshal(body, &request.Body); err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", fmt.Sprintf("Invalid JSON payload: %v.", err))
+			return false
+		}
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	s.requests = append(s.requests, request)
+	return true
+}
+
+func (s *Server) handleListUsers(w http.ResponseWriter, r *http.Request, developer names.Developer) {
+	users := s.listUsers(developer.ID)
+	if s.OnList != nil {
+		for _, user := range users {
+			s.OnList(user)
+		}
+	}
+
+	pageSize := s.MaxPageSize
+	if pageSize == 0 {
+		pageSize = DefaultMaxPageSize
+	}
+	if value := r.URL.Query().Get("pageSize"); value != "" {
+		requested, err := strconv.Atoi(value)
+		if err != nil || requested < 0 {
+			writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", fmt.Sprintf("Invalid page size %q.", value))
+			return
+		}
+		if requested > 0 {
+			pageSize = min(pageSize, requested)
+		}
+	}
+
+	start := 0
+	if token := r.URL.Query().Get("pageToken"); token != "" {
+		var err error
+		start, err = strconv.Atoi(token)
+		if err != nil || start < 0 || start > len(users) {
+			writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", fmt.Sprintf("Invalid page token %q.", token))
+			return
+		}
+	}
+	end := min(start+pageSize, len(users))
+
+	response := androidpublisher.ListUsersResponse{Users: users[start:end]}
+	if end < len(users) {
+		response.NextPageToken = strconv.Itoa(end)
+	}
+	writeJSON(w, response)
+}
+
+func (s *Server) handleCreateUser(w http.ResponseWriter, r *http.Request, developer names.Developer) {
+	var user androidpublisher.User
+	if !decode(w, r, &user) {
+		return
+	}
+	if err := names.ValidateEmail(user.Email); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", err.Error())
+		return
+	}
+	if !validPermissions(w, user.DeveloperAccountPermissions, permissions.DeveloperAccountPermissions) {
+		return
+	}
+
+	name := names.User{DeveloperID: developer.ID, Email: user.Email}
+	if _, ok := s.users[name.String()]; ok {
+		writeError(w, http.StatusConflict, "ALREADY_EXISTS", fmt.Sprintf("User %q already exists.", user.Email))
+		return
+	}
+
+	created := &androidpublisher.User{
+		Name:                        name.String(),
+		Email:                       user.Email,
+		AccessState:                 accessStateInvited,
+		DeveloperAccountPermissions: user.DeveloperAccountPermissions,
+		ExpirationTime:              user.ExpirationTime,
+	}
+	for _, g := range user.Grants {
+		if !s.validGrant(w, g) {
+			return
+		}
+		created.Grants = append(created.Grants, &androidpublisher.Grant{
+			Name:                names.Grant{DeveloperID: developer.ID, Email: user.Email, PackageName: g.PackageName}.String(),
+			PackageName:         g.PackageName,
+			AppLevelPermissions: g.AppLevelPermissions,
+		})
+	}
+	s.users[created.Name] = created
+	writeJSON(w, created)
+}
+
+func (s *Server) handlePatchUser(w http.ResponseWriter, r *http.Request, name names.User) {
+	user, ok := s.users[name.String()]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("User %q not found.", name.Email))
+		return
+	}
+	var patch androidpublisher.User
+	if !decode(w, r, &patch) {
+		return
+	}
+
+	fields, ok := updateMask(w, r, "developerAccountPermissions", "expirationTime")
+	if !ok {
+		return
+	}
+	if slices.Contains(fields, "developerAccountPermissions") {
+		if !validPermissions(w, patch.DeveloperAccountPermissions, permissions.DeveloperAccountPermissions) {
+			return
+		}
+		user.DeveloperAccountPermissions = patch.DeveloperAccountPermissions
+	}
+	if slices.Contains(fields, "expirationTime") {
+		user.ExpirationTime = patch.ExpirationTime
+	}
+	writeJSON(w, user)
+}
+
+func (s *Server) handleDeleteUser(w http.ResponseWriter, name names.User) {
+	if _, ok := s.users[name.String()]; !ok {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("User %q not found.", name.Email))
+		return
+	}
+	delete(s.users, name.String())
+	writeJSON(w, struct{}{})
+}
+
+func (s *Server) handleCreateGrant(w http.ResponseWriter, r *http.Request, name names.User) {
+	user, ok := s.users[name.String()]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("User %q not found.", name.Email))
+		return
+	}
+	var g androidpublisher.Grant
+	if !decode(w, r, &g) {
+		return
+	}
+	if !s.validGrant(w, &g) {
+		return
+	}
+	if findGrant(user, g.PackageName) != nil {
+		writeError(w, http.StatusConflict, "ALREADY_EXISTS", fmt.Sprintf("User %q already has a grant for %q.", name.Email, g.PackageName))
+		return
+	}
+
+	created := &androidpublisher.Grant{
+		Name:                names.Grant{DeveloperID: name.DeveloperID, Email: name.Email, PackageName: g.PackageName}.String(),
+		PackageName:         g.PackageName,
+		AppLevelPermissions: g.AppLevelPermissions,
+	}
+	user.Grants = append(user.Grants, created)
+	writeJSON(w, created)
+}
+
+func (s *Server) handlePatchGrant(w http.ResponseWriter, r *http.Request, name names.Grant) {
+	g := s.findGrant(name)
+	if g == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("Grant %q not found.", name))
+		return
+	}
+	var patch androidpublisher.Grant
+	if !decode(w, r, &patch) {
+		return
+	}
+
+	fields, ok := updateMask(w, r, "appLevelPermissions")
+	if !ok {
+		return
+	}
+	if slices.Contains(fields, "appLevelPermissions") {
+		if !validPermissions(w, patch.AppLevelPermissions, permissions.AppLevelPermissions) {
+			return
+		}
+		g.AppLevelPermissions = patch.AppLevelPermissions
+	}
+	writeJSON(w, g)
+}
+
+func (s *Server) handleDeleteGrant(w http.ResponseWriter, name names.Grant) {
+	user, ok := s.users[name.User().String()]
+	if !ok || findGrant(user, name.PackageName) == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("Grant %q not found.", name))
+		return
+	}
+	user.Grants = slices.DeleteFunc(user.Grants, func(g *androidpublisher.Grant) bool {
+		return g.PackageName == name.PackageName
+	})
+	writeJSON(w, struct{}{})
+}
+
+func (s *Server) handleInsertEdit(w http.ResponseWriter, app names.App) {
+	if !s.apps[app.PackageName] {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("Package not found: %s.", app.PackageName))
+		return
+	}
+	s.nextEditID++
+	edit := &androidpublisher.AppEdit{
+		Id:                strconv.FormatInt(s.nextEditID, 10),
+		ExpiryTimeSeconds: strconv.FormatInt(s.Now().Add(editLifetime).Unix(), 10),
+	}
+	s.edits[names.Edit{PackageName: app.PackageName, EditID: edit.Id}.String()] = edit
+	writeJSON(w, edit)
+}
+
+// openEdit returns the edit, writing an error if it does not exist or has expired.
+func (s *Server) openEdit(w http.ResponseWriter, name names.Edit) (*androidpublisher.AppEdit, bool) {
+	edit, ok := s.edits[name.String()]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("Edit %q not found.", name.EditID))
+		return nil, false
+	}
+	expiry, _ := strconv.ParseInt(edit.ExpiryTimeSeconds, 10, 64)
+	if s.Now().Unix() >= expiry {
+		delete(s.edits, name.String())
+		writeError(w, http.StatusBadRequest, "FAILED_PRECONDITION", fmt.Sprintf("This Edit has been deleted or has expired: %s.", name.EditID))
+		return nil, false
+	}
+	return edit, true
+}
+
+func (s *Server) handleGetEdit(w http.ResponseWriter, name names.Edit) {
+	if edit, ok := s.openEdit(w, name); ok {
+		writeJSON(w, edit)
+	}
+}
+
+func (s *Server) handleDeleteEdit(w http.ResponseWriter, name names.Edit) {
+	if _, ok := s.openEdit(w, name); ok {
+		delete(s.edits, name.String())
+		writeJSON(w, struct{}{})
+	}
+}
+
+func (s *Server) handleValidateEdit(w http.ResponseWriter, name names.Edit) {
+	if edit, ok := s.openEdit(w, name); ok {
+		writeJSON(w, edit)
+	}
+}
+
+func (s *Server) handleCommitEdit(w http.ResponseWriter, name names.Edit) {
+	if edit, ok := s.openEdit(w, name); ok {
+		delete(s.edits, name.String())
+		writeJSON(w, edit)
+	}
+}
+
+// validGrant checks the package and permissions of a grant, writing an error if they are invalid.
+func (s *Server) validGrant(w http.ResponseWriter, g *androidpublisher.Grant) bool {
+	if err := names.ValidatePackageName(g.PackageName); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", err.Error())
+		return false
+	}
+	if !s.apps[g.PackageName] {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("Package not found: %s.", g.PackageName))
+		return false
+	}
+	return validPermissions(w, g.AppLevelPermissions, permissions.AppLevelPermissions)
+}
+
+func (s *Server) findGrant(name names.Grant) *androidpublisher.Grant {
+	user, ok := s.users[name.User().String()]
+	if !ok {
+		return nil
+	}
+	return findGrant(user, name.PackageName)
+}
+
+func findGrant(user *androidpublisher.User, packageName string) *androidpublisher.Grant {
+	for _, g := range user.Grants {
+		if g.PackageName == packageName {
+			return g
+		}
+	}
+	return nil
+}
+
+// validPermissions writes an error if any permission is not one of the allowed values.
+func validPermissions(w http.ResponseWriter, values []string, allowed []string) bool {
+	for _, value := range values {
+		if !slices.Contains(allowed, value) {
+			writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", fmt.Sprintf("Invalid value at permission: %q.", value))
+			return false
+		}
+	}
+	return true
+}
+
+// updateMask returns the fields of the request's update mask, writing an
+// error if it is missing or names a field that cannot be updated.
+func updateMask(w http.ResponseWriter, r *http.Request, allowed ...string) ([]string, bool) {
+	value := r.URL.Query().Get("updateMask")
+	if value == "" {
+		writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "Update mask is required.")
+		return nil, false
+	}
+	fields := strings.Split(value, ",")
+	for _, field := range fields {
+		if !slices.Contains(allowed, field) {
+			writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", fmt.Sprintf("Invalid update mask field %q.", field))
+			return nil, false
+		}
+	}
+	return fields, true
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", fmt.Sprintf("Invalid JSON payload: %v.", err))
+		return false
+	}
+	return true
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusMethodNotAllowed, "UNIMPLEMENTED", fmt.Sprintf("Method %s is not supported for %q.", r.Method, r.URL.Path))
+}
+
+// writeError writes an error in the format of Google APIs, which the client
+// library decodes into a googleapi.Error.
+func writeError(w http.ResponseWriter, code int, status string, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+			"status":  status,
+		},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeplay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/api/androidpublisher/v3"
+	"google.golang.org/api/googleapi"
+)
+
+const (
+	testDeveloper = "developers/123"
+	testPackage   = "com.example.app"
+)
+
+func newTestService(t *testing.T) (*Server, *androidpublisher.Service) {
+	t.Helper()
+
+	fake := New()
+	fake.AddApp(testPackage)
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	service, err := androidpublisher.NewService(context.Background(), ClientOptions(server.URL)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fake, service
+}
+
+func expectStatus(t *testing.T, err error, code int) {
+	t.Helper()
+
+	var apiErr *googleapi.Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an API error with status %d, got %v", code, err)
+	}
+	if apiErr.Code != code {
+		t.Errorf("expected status %d, got %d: %s", code, apiErr.Code, apiErr.Message)
+	}
+}
+
+func TestUsers(t *testing.T) {
+	fake, service := newTestService(t)
+
+	created, err := service.Users.Create(testDeveloper, &androidpublisher.User{
+		Email:                       "someone@example.com",
+		DeveloperAccountPermissions: []string{"CAN_VIEW_APP_QUALITY_GLOBAL"},
+	}).Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Name != "developers/123/users/someone@example.com" || created.AccessState != accessStateInvited {
+		t.Errorf("unexpected created user %+v", created)
+	}
+
+	_, err = service.Users.Create(testDeveloper, &androidpublisher.User{Email: "someone@example.com"}).Do()
+	expectStatus(t, err, http.StatusConflict)
+
+	patched, err := service.Users.Patch(created.Name, &androidpublisher.User{
+		DeveloperAccountPermissions: []string{"CAN_REPLY_TO_REVIEWS_GLOBAL"},
+		ExpirationTime:              "2030-01-01T00:00:00Z",
+	}).UpdateMask("developerAccountPermissions").Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(patched.DeveloperAccountPermissions) != 1 || patched.DeveloperAccountPermissions[0] != "CAN_REPLY_TO_REVIEWS_GLOBAL" {
+		t.Errorf("expected permissions to be patched, got %v", patched.DeveloperAccountPermissions)
+	}
+	if patched.ExpirationTime != "" {
+		t.Errorf("expected fields outside the update mask to be ignored, got expiration %q", patched.ExpirationTime)
+	}
+
+	_, err = service.Users.Patch(created.Name, &androidpublisher.User{}).Do()
+	expectStatus(t, err, http.StatusBadRequest)
+
+	if err := service.Users.Delete(created.Name).Do(); err != nil {
+		t.Fatal(err)
+	}
+	if users := fake.Users("123"); len(users) != 0 {
+		t.Errorf("expected the user to be deleted, got %v", users)
+	}
+	expectStatus(t, service.Users.Delete(created.Name).Do(), http.StatusNotFound)
+}
+
+func TestUsersErrors(t *testing.T) {
+	_, service := newTestService(t)
+
+	tests := map[string]struct {
+		call func() error
+		code int
+	}{
+		"invalid email": {
+			call: func() error {
+				_, err := service.Users.Create(testDeveloper, &androidpublisher.User{Email: "someone"}).Do()
+				return err
+			},
+			code: http.StatusBadRequest,
+		},
+		"unknown permission": {
+			call: func() error {
+				_, err := service.Users.Create(testDeveloper, &androidpublisher.User{Email: "someone@example.com", DeveloperAccountPermissions: []string{"CAN_DO_ANYTHING"}}).Do()
+				return err
+			},
+			code: http.StatusBadRequest,
+		},
+		"patch unknown user": {
+			call: func() error {
+				_, err := service.Users.Patch("developers/123/users/someone@example.com", &androidpublisher.User{}).Do()
+				return err
+			},
+			code: http.StatusNotFound,
+		},
+		"invalid developer": {
+			call: func() error {
+				_, err := service.Users.List("developers/abc").Do()
+				return err
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			expectStatus(t, tt.call(), tt.code)
+		})
+	}
+}
+
+func TestUsersPagination(t *testing.T) {
+	fake, service := newTestService(t)
+	fake.MaxPageSize = 3
+	for i := range 7 {
+		fake.PutUser("123", &androidpublisher.User{Email: fmt.Sprintf("user%d@example.com", i)})
+	}
+
+	var pages, users int
+	err := service.Users.List(testDeveloper).PageSize(100).Pages(context.Background(), func(response *androidpublisher.ListUsersResponse) error {
+		pages++
+		users += len(response.Users)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pages != 3 || users != 7 {
+		t.Errorf("expected 7 users in 3 pages, got %d users in %d pages", users, pages)
+	}
+
+	_, err = service.Users.List(testDeveloper).PageToken("bogus").Do()
+	expectStatus(t, err, http.StatusBadRequest)
+}
+
+func TestRequests(t *testing.T) {
+	fake, service := newTestService(t)
+	fake.PutUser("123", &androidpublisher.User{Email: "someone@example.com", AccessState: accessStateInvited})
+	fake.OnList = func(user *androidpublisher.User) { user.AccessState = accessStateGranted }
+
+	listed, err := service.Users.List(testDeveloper).Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listed.Users) != 1 || listed.Users[0].AccessState != accessStateGranted {
+		t.Errorf("expected OnList to change the listed user, got %+v", listed.Users)
+	}
+
+	_, err = service.Users.Patch(testDeveloper+"/users/someone@example.com", &androidpublisher.User{
+		DeveloperAccountPermissions: []string{"CAN_VIEW_APP_QUALITY_GLOBAL"},
+	}).UpdateMask("developerAccountPermissions").Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Request{
+		{Method: http.MethodGet, Name: testDeveloper + "/users"},
+		{
+			Method:     http.MethodPatch,
+			Name:       testDeveloper + "/users/someone@example.com",
+			UpdateMask: "developerAccountPermissions",
+			Body:       map[string]interface{}{"developerAccountPermissions": []interface{}{"CAN_VIEW_APP_QUALITY_GLOBAL"}},
+		},
+	}
+	if requests := fake.Requests(); !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %+v, got %+v", expected, requests)
+	}
+}
+
+func TestGrants(t *testing.T) {
+	fake, service := newTestService(t)
+	fake.SeedAccount("123", "owner@example.com")
+	user := "developers/123/users/owner@example.com"
+
+	created, err := service.Grants.Create(user, &androidpublisher.Grant{
+		PackageName:         testPackage,
+		AppLevelPermissions: []string{"CAN_REPLY_TO_REVIEWS"},
+	}).Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Name != user+"/grants/"+testPackage {
+		t.Errorf("unexpected grant name %q", created.Name)
+	}
+
+	_, err = service.Grants.Create(user, &androidpublisher.Grant{PackageName: testPackage}).Do()
+	expectStatus(t, err, http.StatusConflict)
+	_, err = service.Grants.Create(user, &androidpublisher.Grant{PackageName: "com.example.unknown"}).Do()
+	expectStatus(t, err, http.StatusNotFound)
+	_, err = service.Grants.Create("developers/123/users/nobody@example.com", &androidpublisher.Grant{PackageName: testPackage}).Do()
+	expectStatus(t, err, http.StatusNotFound)
+
+	_, err = service.Grants.Patch(created.Name, &androidpublisher.Grant{
+		AppLevelPermissions: []string{"CAN_VIEW_APP_QUALITY"},
+	}).UpdateMask("appLevelPermissions").Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	grants := fake.Users("123")[0].Grants
+	if len(grants) != 1 || grants[0].AppLevelPermissions[0] != "CAN_VIEW_APP_QUALITY" {
+		t.Errorf("expected the grant to be patched, got %+v", grants)
+	}
+
+	if err := service.Grants.Delete(created.Name).Do(); err != nil {
+		t.Fatal(err)
+	}
+	if grants := fake.Users("123")[0].Grants; len(grants) != 0 {
+		t.Errorf("expected the grant to be deleted, got %+v", grants)
+	}
+	expectStatus(t, service.Grants.Delete(created.Name).Do(), http.StatusNotFound)
+}
+
+func TestEdits(t *testing.T) {
+	fake, service := newTestService(t)
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	fake.Now = func() time.Time { return now }
+
+	edit, err := service.Edits.Insert(testPackage, &androidpublisher.AppEdit{}).Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.Edits.Get(testPackage, edit.Id).Do(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.Edits.Validate(testPackage, edit.Id).Do(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.Edits.Commit(testPackage, edit.Id).Do(); err != nil {
+		t.Fatal(err)
+	}
+	if edits := fake.Edits(); len(edits) != 0 {
+		t.Errorf("expected the committed edit to be closed, got %v", edits)
+	}
+	_, err = service.Edits.Commit(testPackage, edit.Id).Do()
+	expectStatus(t, err, http.StatusNotFound)
+
+	expiring, err := service.Edits.Insert(testPackage, &androidpublisher.AppEdit{}).Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(editLifetime)
+	_, err = service.Edits.Get(testPackage, expiring.Id).Do()
+	expectStatus(t, err, http.StatusBadRequest)
+
+	deleted, err := service.Edits.Insert(testPackage, &androidpublisher.AppEdit{}).Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := service.Edits.Delete(testPackage, deleted.Id).Do(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = service.Edits.Insert("com.example.unknown", &androidpublisher.AppEdit{}).Do()
+	expectStatus(t, err, http.StatusNotFound)
+}
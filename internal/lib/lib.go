@@ -11,10 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
-func DeveloperIDToParentFragment(developerID string) string {
-	return "developers/" + developerID
-}
-
 func TFListToList[T any](ctx context.Context, list types.List) ([]T, diag.Diagnostics) {
 	var slice []T
 	diags := list.ElementsAs(ctx, &slice, true)
@@ -31,7 +27,3 @@ func StrListToTfModel(strList []string) basetypes.ListValue {
 	}
 	return types.ListValueMust(types.StringType, res)
 }
-
-func GetName(userEmail string, developerId string) string {
-	return "developers/" + developerId + "/users/" + userEmail
-}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package mask computes update masks for Patch calls from the difference
+// between a resource's planned and current models.
+package mask
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Fields maps the tfsdk attribute names of a resource model to the API field
+// names used in its update mask.
+type Fields map[string]string
+
+// Mask is the list of API fields to update.
+type Mask []string
+
+// String renders the mask in the comma-separated form expected by updateMask.
+func (m Mask) String() string {
+	return strings.Join(m, ",")
+}
+
+// Contains reports whether the API field is part of the mask.
+func (m Mask) Contains(field string) bool {
+	for _, f := range m {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// Diff returns the API fields whose attributes differ between planned and
+// current, which must be pointers to the same resource model struct. Unknown
+// planned values are treated as changed, and values with semantic equality
+// are compared semantically. The mask is sorted by API field name.
+func Diff(ctx context.Context, planned any, current any, fields Fields) (Mask, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	plannedValue := reflect.ValueOf(planned)
+	currentValue := reflect.ValueOf(current)
+	if plannedValue.Kind() != reflect.Pointer || currentValue.Type() != plannedValue.Type() || plannedValue.Elem().Kind() != reflect.Struct {
+		diags.AddError("Invalid update mask models", fmt.Sprintf("Expected two pointers to the same struct, got %T and %T. Please report this issue to the provider developers.", planned, current))
+		return nil, diags
+	}
+	plannedValue, currentValue = plannedValue.Elem(), currentValue.Elem()
+
+	mask := make(Mask, 0, len(fields))
+	found := make(map[string]bool, len(fields))
+	// Visible fields include those of embedded structs, as in framework models.
+	for _, structField := range reflect.VisibleFields(plannedValue.Type()) {
+		if structField.Anonymous {
+			continue
+		}
+		tag := structField.Tag.Get("tfsdk")
+		field, ok := fields[tag]
+		if !ok {
+			continue
+		}
+		found[tag] = true
+
+		a, aOk := plannedValue.FieldByIndex(structField.Index).Interface().(attr.Value)
+		b, bOk := currentValue.FieldByIndex(structField.Index).Interface().(attr.Value)
+		if !aOk || !bOk {
+			diags.AddError("Invalid update mask models", fmt.Sprintf("Attribute %q is not a framework value. Please report this issue to the provider developers.", tag))
+			return nil, diags
+		}
+
+		equal, d := valuesEqual(ctx, a, b)
+		diags.Append(d...)
+		if !equal {
+			mask = append(mask, field)
+		}
+	}
+
+	for tag := range fields {
+		if !found[tag] {
+			diags.AddError("Invalid update mask fields", fmt.Sprintf("Attribute %q does not exist in %T. Please report this issue to the provider developers.", tag, planned))
+		}
+	}
+
+	sort.Strings(mask)
+	return mask, diags
+}
+
+func valuesEqual(ctx context.Context, planned attr.Value, current attr.Value) (bool, diag.Diagnostics) {
+	if planned.IsUnknown() {
+		return false, nil
+	}
+	if planned.Equal(current) {
+		return true, nil
+	}
+	if planned.IsNull() || current.IsNull() || current.IsUnknown() {
+		return false, nil
+	}
+
+	if semantic, ok := planned.(basetypes.StringValuableWithSemanticEquals); ok {
+		if other, ok := current.(basetypes.StringValuable); ok {
+			return semantic.StringSemanticEquals(ctx, other)
+		}
+	}
+	return false, nil
+}
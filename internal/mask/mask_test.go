@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mask
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/timetypes"
+)
+
+type model struct {
+	Name       types.String      `tfsdk:"name"`
+	Count      types.Int64       `tfsdk:"count"`
+	Expiration timetypes.RFC3339 `tfsdk:"expiration"`
+	Ignored    types.String      `tfsdk:"ignored"`
+}
+
+var fields = Fields{
+	"name":       "displayName",
+	"count":      "count",
+	"expiration": "expirationTime",
+}
+
+func TestDiff(t *testing.T) {
+	current := model{
+		Name:       types.StringValue("a"),
+		Count:      types.Int64Value(1),
+		Expiration: timetypes.NewRFC3339Value("2030-01-01T00:00:00Z"),
+		Ignored:    types.StringValue("x"),
+	}
+
+	tests := map[string]struct {
+		planned model
+		want    Mask
+	}{
+		"unchanged": {current, Mask{}},
+		"ignored attribute changed": {
+			model{Name: current.Name, Count: current.Count, Expiration: current.Expiration, Ignored: types.StringValue("y")},
+			Mask{},
+		},
+		"semantically equal": {
+			model{Name: current.Name, Count: current.Count, Expiration: timetypes.NewRFC3339Value("2030-01-01T01:00:00+01:00"), Ignored: current.Ignored},
+			Mask{},
+		},
+		"changed and sorted": {
+			model{Name: types.StringValue("b"), Count: types.Int64Value(2), Expiration: current.Expiration, Ignored: current.Ignored},
+			Mask{"count", "displayName"},
+		},
+		"cleared": {
+			model{Name: current.Name, Count: current.Count, Expiration: timetypes.NewRFC3339Null(), Ignored: current.Ignored},
+			Mask{"expirationTime"},
+		},
+		"unknown": {
+			model{Name: types.StringUnknown(), Count: current.Count, Expiration: current.Expiration, Ignored: current.Ignored},
+			Mask{"displayName"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, diags := Diff(context.Background(), &tt.planned, &current, fields)
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDiffEmbedded(t *testing.T) {
+	type embedding struct {
+		model
+		Extra types.String `tfsdk:"extra"`
+	}
+	current := embedding{model: model{Name: types.StringValue("a")}, Extra: types.StringValue("x")}
+	planned := embedding{model: model{Name: types.StringValue("b")}, Extra: types.StringValue("y")}
+
+	got, diags := Diff(context.Background(), &planned, &current, Fields{"name": "displayName", "extra": "extra"})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if want := (Mask{"displayName", "extra"}); !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestDiffInvalidFields(t *testing.T) {
+	var a, b model
+	if _, diags := Diff(context.Background(), &a, &b, Fields{"missing": "missing"}); !diags.HasError() {
+		t.Error("expected an error for an attribute missing from the model")
+	}
+	if _, diags := Diff(context.Background(), a, b, fields); !diags.HasError() {
+		t.Error("expected an error for models passed by value")
+	}
+}
+
+func TestMaskString(t *testing.T) {
+	if got := (Mask{"a", "b"}).String(); got != "a,b" {
+		t.Errorf("expected a,b, got %q", got)
+	}
+}
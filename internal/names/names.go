@@ -0,0 +1,391 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package names models the resource names of the Google Play Developer API,
+// such as "developers/{developer}/users/{email}". Every name type has a Parse
+// function, a String method that formats the name and a Validate method that
+// checks its components.
+package names
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+)
+
+// Formats of the resource names, with each component in braces.
+const (
+	DeveloperFormat    = "developers/{developer}"
+	UserFormat         = "developers/{developer}/users/{email}"
+	GrantFormat        = "developers/{developer}/users/{email}/grants/{package_name}"
+	AppFormat          = "applications/{package_name}"
+	EditFormat         = "applications/{package_name}/edits/{edit}"
+	TrackFormat        = "applications/{package_name}/edits/{edit}/tracks/{track}"
+	SubscriptionFormat = "applications/{package_name}/subscriptions/{product_id}"
+	BasePlanFormat     = "applications/{package_name}/subscriptions/{product_id}/basePlans/{base_plan}"
+	OfferFormat        = "applications/{package_name}/subscriptions/{product_id}/basePlans/{base_plan}/offers/{offer}"
+	InAppProductFormat = "applications/{package_name}/inappproducts/{sku}"
+)
+
+var (
+	developerIDPattern = regexp.MustCompile(`^[0-9]+$`)
+	packageNamePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*(\.[a-zA-Z][a-zA-Z0-9_]*)+$`)
+	// Product IDs start with a lowercase letter or number and contain
+	// lowercase letters, numbers, underscores and periods.
+	productIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.]*$`)
+	// Base plan and offer IDs start with a lowercase letter or number and
+	// contain lowercase letters, numbers and hyphens.
+	basePlanIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+)
+
+const (
+	maxProductIDLength  = 139
+	maxBasePlanIDLength = 63
+)
+
+// ValidateDeveloperID checks that the developer account ID is numeric.
+func ValidateDeveloperID(developerID string) error {
+	if !developerIDPattern.MatchString(developerID) {
+		return fmt.Errorf("%q is not a valid developer ID, which must be numeric", developerID)
+	}
+	return nil
+}
+
+// ValidateEmail checks that the email is a bare address.
+func ValidateEmail(email string) error {
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email || strings.Contains(email, "/") {
+		return fmt.Errorf("%q is not a valid email address", email)
+	}
+	return nil
+}
+
+// ValidatePackageName checks that the package name is a valid Android application ID.
+func ValidatePackageName(packageName string) error {
+	if !packageNamePattern.MatchString(packageName) {
+		return fmt.Errorf("%q is not a valid package name, such as com.example.app", packageName)
+	}
+	return nil
+}
+
+// ValidateSKU checks the product ID of an in-app product or subscription.
+func ValidateSKU(sku string) error {
+	if len(sku) > maxProductIDLength || !productIDPattern.MatchString(sku) {
+		return fmt.Errorf("%q is not a valid product ID, which must start with a lowercase letter or number, contain only lowercase letters, numbers, underscores and periods, and be at most %d characters", sku, maxProductIDLength)
+	}
+	return nil
+}
+
+// ValidateBasePlanID checks the ID of a base plan or offer.
+func ValidateBasePlanID(id string) error {
+	if len(id) > maxBasePlanIDLength || !basePlanIDPattern.MatchString(id) {
+		return fmt.Errorf("%q is not a valid ID, which must start with a lowercase letter or number, contain only lowercase letters, numbers and hyphens, and be at most %d characters", id, maxBasePlanIDLength)
+	}
+	return nil
+}
+
+// ValidateSegment checks that an opaque component, such as an edit ID or a
+// track, is non-empty and does not contain a slash.
+func ValidateSegment(kind string, value string) error {
+	if value == "" || strings.Contains(value, "/") {
+		return fmt.Errorf("%q is not a valid %s", value, kind)
+	}
+	return nil
+}
+
+// parse matches the name against the format and returns its components in order.
+func parse(format string, name string) ([]string, error) {
+	formatParts := strings.Split(format, "/")
+	parts := strings.Split(name, "/")
+	if len(parts) != len(formatParts) {
+		return nil, fmt.Errorf("expected name in the format %q, got %q", format, name)
+	}
+
+	var components []string
+	for i, formatPart := range formatParts {
+		if strings.HasPrefix(formatPart, "{") {
+			components = append(components, parts[i])
+			continue
+		}
+		if parts[i] != formatPart {
+			return nil, fmt.Errorf("expected name in the format %q, got %q", format, name)
+		}
+	}
+	return components, nil
+}
+
+// firstError returns the first non-nil error.
+func firstError(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Developer is a developer account.
+type Developer struct {
+	ID string
+}
+
+func ParseDeveloper(name string) (Developer, error) {
+	c, err := parse(DeveloperFormat, name)
+	if err != nil {
+		return Developer{}, err
+	}
+	d := Developer{ID: c[0]}
+	return d, d.Validate()
+}
+
+func (d Developer) String() string {
+	return "developers/" + d.ID
+}
+
+func (d Developer) Validate() error {
+	return ValidateDeveloperID(d.ID)
+}
+
+// User is a user of a developer account.
+type User struct {
+	DeveloperID string
+	Email       string
+}
+
+func ParseUser(name string) (User, error) {
+	c, err := parse(UserFormat, name)
+	if err != nil {
+		return User{}, err
+	}
+	u := User{DeveloperID: c[0], Email: c[1]}
+	return u, u.Validate()
+}
+
+func (u User) Developer() Developer {
+	return Developer{ID: u.DeveloperID}
+}
+
+func (u User) String() string {
+	return u.Developer().String() + "/users/" + u.Email
+}
+
+func (u User) Validate() error {
+	return firstError(ValidateDeveloperID(u.DeveloperID), ValidateEmail(u.Email))
+}
+
+// Grant is a user's access to a single app.
+type Grant struct {
+	DeveloperID string
+	Email       string
+	PackageName string
+}
+
+func ParseGrant(name string) (Grant, error) {
+	c, err := parse(GrantFormat, name)
+	if err != nil {
+		return Grant{}, err
+	}
+	g := Grant{DeveloperID: c[0], Email: c[1], PackageName: c[2]}
+	return g, g.Validate()
+}
+
+func (g Grant) User() User {
+	return User{DeveloperID: g.DeveloperID, Email: g.Email}
+}
+
+func (g Grant) String() string {
+	return g.User().String() + "/grants/" + g.PackageName
+}
+
+func (g Grant) Validate() error {
+	return firstError(g.User().Validate(), ValidatePackageName(g.PackageName))
+}
+
+// App is an application.
+type App struct {
+	PackageName string
+}
+
+func ParseApp(name string) (App, error) {
+	c, err := parse(AppFormat, name)
+	if err != nil {
+		return App{}, err
+	}
+	a := App{PackageName: c[0]}
+	return a, a.Validate()
+}
+
+func (a App) String() string {
+	return "applications/" + a.PackageName
+}
+
+func (a App) Validate() error {
+	return ValidatePackageName(a.PackageName)
+}
+
+// Edit is an app edit.
+type Edit struct {
+	PackageName string
+	EditID      string
+}
+
+func ParseEdit(name string) (Edit, error) {
+	c, err := parse(EditFormat, name)
+	if err != nil {
+		return Edit{}, err
+	}
+	e := Edit{PackageName: c[0], EditID: c[1]}
+	return e, e.Validate()
+}
+
+func (e Edit) App() App {
+	return App{PackageName: e.PackageName}
+}
+
+func (e Edit) String() string {
+	return e.App().String() + "/edits/" + e.EditID
+}
+
+func (e Edit) Validate() error {
+	return firstError(e.App().Validate(), ValidateSegment("edit ID", e.EditID))
+}
+
+// Track is a release track within an edit.
+type Track struct {
+	PackageName string
+	EditID      string
+	Track       string
+}
+
+func ParseTrack(name string) (Track, error) {
+	c, err := parse(TrackFormat, name)
+	if err != nil {
+		return Track{}, err
+	}
+	t := Track{PackageName: c[0], EditID: c[1], Track: c[2]}
+	return t, t.Validate()
+}
+
+func (t Track) Edit() Edit {
+	return Edit{PackageName: t.PackageName, EditID: t.EditID}
+}
+
+func (t Track) String() string {
+	return t.Edit().String() + "/tracks/" + t.Track
+}
+
+func (t Track) Validate() error {
+	return firstError(t.Edit().Validate(), ValidateSegment("track", t.Track))
+}
+
+// Subscription is a subscription product.
+type Subscription struct {
+	PackageName string
+	ProductID   string
+}
+
+func ParseSubscription(name string) (Subscription, error) {
+	c, err := parse(SubscriptionFormat, name)
+	if err != nil {
+		return Subscription{}, err
+	}
+	s := Subscription{PackageName: c[0], ProductID: c[1]}
+	return s, s.Validate()
+}
+
+func (s Subscription) App() App {
+	return App{PackageName: s.PackageName}
+}
+
+func (s Subscription) String() string {
+	return s.App().String() + "/subscriptions/" + s.ProductID
+}
+
+func (s Subscription) Validate() error {
+	return firstError(s.App().Validate(), ValidateSKU(s.ProductID))
+}
+
+// BasePlan is a base plan of a subscription.
+type BasePlan struct {
+	PackageName string
+	ProductID   string
+	BasePlanID  string
+}
+
+func ParseBasePlan(name string) (BasePlan, error) {
+	c, err := parse(BasePlanFormat, name)
+	if err != nil {
+		return BasePlan{}, err
+	}
+	b := BasePlan{PackageName: c[0], ProductID: c[1], BasePlanID: c[2]}
+	return b, b.Validate()
+}
+
+func (b BasePlan) Subscription() Subscription {
+	return Subscription{PackageName: b.PackageName, ProductID: b.ProductID}
+}
+
+func (b BasePlan) String() string {
+	return b.Subscription().String() + "/basePlans/" + b.BasePlanID
+}
+
+func (b BasePlan) Validate() error {
+	return firstError(b.Subscription().Validate(), ValidateBasePlanID(b.BasePlanID))
+}
+
+// Offer is an offer of a subscription base plan.
+type Offer struct {
+	PackageName string
+	ProductID   string
+	BasePlanID  string
+	OfferID     string
+}
+
+func ParseOffer(name string) (Offer, error) {
+	c, err := parse(OfferFormat, name)
+	if err != nil {
+		return Offer{}, err
+	}
+	o := Offer{PackageName: c[0], ProductID: c[1], BasePlanID: c[2], OfferID: c[3]}
+	return o, o.Validate()
+}
+
+func (o Offer) BasePlan() BasePlan {
+	return BasePlan{PackageName: o.PackageName, ProductID: o.ProductID, BasePlanID: o.BasePlanID}
+}
+
+func (o Offer) String() string {
+	return o.BasePlan().String() + "/offers/" + o.OfferID
+}
+
+func (o Offer) Validate() error {
+	return firstError(o.BasePlan().Validate(), ValidateBasePlanID(o.OfferID))
+}
+
+// InAppProduct is a managed in-app product.
+type InAppProduct struct {
+	PackageName string
+	SKU         string
+}
+
+func ParseInAppProduct(name string) (InAppProduct, error) {
+	c, err := parse(InAppProductFormat, name)
+	if err != nil {
+		return InAppProduct{}, err
+	}
+	p := InAppProduct{PackageName: c[0], SKU: c[1]}
+	return p, p.Validate()
+}
+
+func (p InAppProduct) App() App {
+	return App{PackageName: p.PackageName}
+}
+
+func (p InAppProduct) String() string {
+	return p.App().String() + "/inappproducts/" + p.SKU
+}
+
+func (p InAppProduct) Validate() error {
+	return firstError(p.App().Validate(), ValidateSKU(p.SKU))
+}
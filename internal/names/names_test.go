@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package names
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func(string) (fmt.Stringer, error)
+	}{
+		{"developers/123", func(s string) (fmt.Stringer, error) { return ParseDeveloper(s) }},
+		{"developers/123/users/someone@example.com", func(s string) (fmt.Stringer, error) { return ParseUser(s) }},
+		{"developers/123/users/someone@example.com/grants/com.example.app", func(s string) (fmt.Stringer, error) { return ParseGrant(s) }},
+		{"applications/com.example.app", func(s string) (fmt.Stringer, error) { return ParseApp(s) }},
+		{"applications/com.example.app/edits/1716211424394", func(s string) (fmt.Stringer, error) { return ParseEdit(s) }},
+		{"applications/com.example.app/edits/1716211424394/tracks/internal", func(s string) (fmt.Stringer, error) { return ParseTrack(s) }},
+		{"applications/com.example.app/subscriptions/premium.monthly", func(s string) (fmt.Stringer, error) { return ParseSubscription(s) }},
+		{"applications/com.example.app/subscriptions/premium/basePlans/monthly-autorenew", func(s string) (fmt.Stringer, error) { return ParseBasePlan(s) }},
+		{"applications/com.example.app/subscriptions/premium/basePlans/monthly/offers/free-trial", func(s string) (fmt.Stringer, error) { return ParseOffer(s) }},
+		{"applications/com.example.app/inappproducts/coins_100", func(s string) (fmt.Stringer, error) { return ParseInAppProduct(s) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := tt.parse(tt.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if parsed.String() != tt.name {
+				t.Errorf("expected %q, got %q", tt.name, parsed.String())
+			}
+		})
+	}
+}
+
+func TestParseGrantComponents(t *testing.T) {
+	g, err := ParseGrant("developers/123/users/someone@example.com/grants/com.example.app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Grant{DeveloperID: "123", Email: "someone@example.com", PackageName: "com.example.app"}
+	if g != expected {
+		t.Errorf("expected %+v, got %+v", expected, g)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := map[string]func() error{
+		"wrong collection": func() error {
+			_, err := ParseUser("developers/123/people/someone@example.com")
+			return err
+		},
+		"extra segment": func() error {
+			_, err := ParseUser("developers/123/users/someone@example.com/grants/com.example.app")
+			return err
+		},
+		"non-numeric developer": func() error {
+			_, err := ParseDeveloper("developers/abc")
+			return err
+		},
+		"invalid email": func() error {
+			_, err := ParseUser("developers/123/users/someone")
+			return err
+		},
+		"empty package name": func() error {
+			_, err := ParseGrant("developers/123/users/someone@example.com/grants/")
+			return err
+		},
+		"single segment package name": func() error {
+			_, err := ParseApp("applications/app")
+			return err
+		},
+		"empty edit": func() error {
+			_, err := ParseEdit("applications/com.example.app/edits/")
+			return err
+		},
+		"uppercase sku": func() error {
+			_, err := ParseInAppProduct("applications/com.example.app/inappproducts/Coins")
+			return err
+		},
+		"underscore base plan": func() error {
+			_, err := ParseBasePlan("applications/com.example.app/subscriptions/premium/basePlans/monthly_plan")
+			return err
+		},
+		"long offer": func() error {
+			_, err := ParseOffer("applications/com.example.app/subscriptions/premium/basePlans/monthly/offers/" + strings.Repeat("a", 64))
+			return err
+		},
+	}
+
+	for name, parse := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := parse(); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestValidateSKU(t *testing.T) {
+	for _, sku := range []string{"coins", "100_coins", "com.example.coins"} {
+		if err := ValidateSKU(sku); err != nil {
+			t.Errorf("%s: %v", sku, err)
+		}
+	}
+	for _, sku := range []string{"", "_coins", "coins-100", "Coins", strings.Repeat("a", 140)} {
+		if err := ValidateSKU(sku); err == nil {
+			t.Errorf("%q: expected an error", sku)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package permissions
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ManagePermissionsGlobal lets a user administer the access of every other user.
+const ManagePermissionsGlobal = "CAN_MANAGE_PERMISSIONS_GLOBAL"
+
+// DeveloperAccountPermissions are the values accepted in User.DeveloperAccountPermissions.
+var DeveloperAccountPermissions = []string{
+	"CAN_SEE_ALL_APPS",
+	"CAN_VIEW_FINANCIAL_DATA_GLOBAL",
+	"CAN_MANAGE_PERMISSIONS_GLOBAL",
+	"CAN_EDIT_GAMES_GLOBAL",
+	"CAN_PUBLISH_GAMES_GLOBAL",
+	"CAN_REPLY_TO_REVIEWS_GLOBAL",
+	"CAN_MANAGE_PUBLIC_APKS_GLOBAL",
+	"CAN_MANAGE_TRACK_APKS_GLOBAL",
+	"CAN_MANAGE_TRACK_USERS_GLOBAL",
+	"CAN_MANAGE_PUBLIC_LISTING_GLOBAL",
+	"CAN_MANAGE_DRAFT_APPS_GLOBAL",
+	"CAN_CREATE_MANAGED_PLAY_APPS_GLOBAL",
+	"CAN_CHANGE_MANAGED_PLAY_SETTING_GLOBAL",
+	"CAN_MANAGE_ORDERS_GLOBAL",
+	"CAN_MANAGE_APP_CONTENT_GLOBAL",
+	"CAN_VIEW_NON_FINANCIAL_DATA_GLOBAL",
+	"CAN_VIEW_APP_QUALITY_GLOBAL",
+	"CAN_MANAGE_DEEPLINKS_GLOBAL",
+}
+
+// AppLevelPermissions are the values accepted in Grant.AppLevelPermissions.
+var AppLevelPermissions = []string{
+	"CAN_ACCESS_APP",
+	"CAN_VIEW_FINANCIAL_DATA",
+	"CAN_MANAGE_PERMISSIONS",
+	"CAN_REPLY_TO_REVIEWS",
+	"CAN_MANAGE_PUBLIC_APKS",
+	"CAN_MANAGE_TRACK_APKS",
+	"CAN_MANAGE_TRACK_USERS",
+	"CAN_MANAGE_PUBLIC_LISTING",
+	"CAN_MANAGE_DRAFT_APPS",
+	"CAN_MANAGE_ORDERS",
+	"CAN_MANAGE_APP_CONTENT",
+	"CAN_VIEW_NON_FINANCIAL_DATA",
+	"CAN_VIEW_APP_QUALITY",
+	"CAN_MANAGE_DEEPLINKS",
+}
+
+// Sensitive are the permissions that grant administrative, financial or
+// production release powers, at the developer account or app level.
+var Sensitive = []string{
+	ManagePermissionsGlobal,
+	"CAN_VIEW_FINANCIAL_DATA_GLOBAL",
+	"CAN_MANAGE_ORDERS_GLOBAL",
+	"CAN_MANAGE_PUBLIC_APKS_GLOBAL",
+	"CAN_MANAGE_PERMISSIONS",
+	"CAN_VIEW_FINANCIAL_DATA",
+	"CAN_MANAGE_ORDERS",
+	"CAN_MANAGE_PUBLIC_APKS",
+}
+
+// NotSensitive returns the values that are not sensitive permissions.
+func NotSensitive(values []string) []string {
+	var notSensitive []string
+	for _, value := range values {
+		if !slices.Contains(Sensitive, value) {
+			notSensitive = append(notSensitive, value)
+		}
+	}
+	return notSensitive
+}
+
+// Role is a named set of permissions, for use across the developer account
+// or for a single app.
+type Role struct {
+	DeveloperAccountPermissions []string
+	AppLevelPermissions         []string
+}
+
+// newRole builds a role from developer account permissions, deriving the
+// equivalent app-level permission for each one that has one.
+func newRole(developerAccountPermissions ...string) Role {
+	role := Role{DeveloperAccountPermissions: developerAccountPermissions}
+	for _, permission := range developerAccountPermissions {
+		appPermission := strings.TrimSuffix(permission, "_GLOBAL")
+		if slices.Contains(AppLevelPermissions, appPermission) {
+			role.AppLevelPermissions = append(role.AppLevelPermissions, appPermission)
+		}
+	}
+	return role
+}
+
+// BuiltinRoles are the roles available without any provider configuration.
+var BuiltinRoles = map[string]Role{
+	"admin": newRole(
+		ManagePermissionsGlobal,
+	),
+	"release_manager": newRole(
+		"CAN_MANAGE_PUBLIC_APKS_GLOBAL",
+		"CAN_MANAGE_TRACK_APKS_GLOBAL",
+		"CAN_MANAGE_TRACK_USERS_GLOBAL",
+		"CAN_MANAGE_PUBLIC_LISTING_GLOBAL",
+		"CAN_MANAGE_DRAFT_APPS_GLOBAL",
+		"CAN_MANAGE_APP_CONTENT_GLOBAL",
+		"CAN_VIEW_NON_FINANCIAL_DATA_GLOBAL",
+		"CAN_VIEW_APP_QUALITY_GLOBAL",
+	),
+	"support_agent": newRole(
+		"CAN_REPLY_TO_REVIEWS_GLOBAL",
+		"CAN_VIEW_NON_FINANCIAL_DATA_GLOBAL",
+		"CAN_VIEW_APP_QUALITY_GLOBAL",
+	),
+	"finance_viewer": newRole(
+		"CAN_VIEW_FINANCIAL_DATA_GLOBAL",
+		"CAN_VIEW_NON_FINANCIAL_DATA_GLOBAL",
+	),
+	"viewer": newRole(
+		"CAN_VIEW_NON_FINANCIAL_DATA_GLOBAL",
+		"CAN_VIEW_APP_QUALITY_GLOBAL",
+	),
+}
+
+// RoleNames returns the names of the roles in sorted order.
+func RoleNames(roles map[string]Role) []string {
+	names := make([]string, 0, len(roles))
+	for name := range roles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate checks that every permission of the role is a known enum value.
+func (r Role) Validate() error {
+	var unknown []string
+	for _, permission := range r.DeveloperAccountPermissions {
+		if !slices.Contains(DeveloperAccountPermissions, permission) {
+			unknown = append(unknown, permission)
+		}
+	}
+	for _, permission := range r.AppLevelPermissions {
+		if !slices.Contains(AppLevelPermissions, permission) {
+			unknown = append(unknown, permission)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown permissions: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// WithCustomRoles returns the built-in roles merged with the custom roles.
+// Custom roles must be valid and must not redefine a built-in role.
+func WithCustomRoles(custom map[string]Role) (map[string]Role, error) {
+	roles := make(map[string]Role, len(BuiltinRoles)+len(custom))
+	for name, role := range BuiltinRoles {
+		roles[name] = role
+	}
+	for _, name := range RoleNames(custom) {
+		role := custom[name]
+		if _, ok := BuiltinRoles[name]; ok {
+			return nil, fmt.Errorf("custom role %q conflicts with the built-in role of the same name", name)
+		}
+		if err := role.Validate(); err != nil {
+			return nil, fmt.Errorf("custom role %q: %w", name, err)
+		}
+		roles[name] = role
+	}
+	return roles, nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package permissions
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBuiltinRolesAreValid(t *testing.T) {
+	for name, role := range BuiltinRoles {
+		if err := role.Validate(); err != nil {
+			t.Errorf("built-in role %q: %v", name, err)
+		}
+	}
+}
+
+func TestNewRoleDerivesAppLevelPermissions(t *testing.T) {
+	role := newRole("CAN_REPLY_TO_REVIEWS_GLOBAL", "CAN_EDIT_GAMES_GLOBAL")
+	if !slices.Equal(role.AppLevelPermissions, []string{"CAN_REPLY_TO_REVIEWS"}) {
+		t.Errorf("expected only permissions with an app-level equivalent, got %v", role.AppLevelPermissions)
+	}
+}
+
+func TestNotSensitive(t *testing.T) {
+	got := NotSensitive([]string{ManagePermissionsGlobal, "CAN_VIEW_APP_QUALITY_GLOBAL", "CAN_MANAGE_ORDERS", "BOGUS"})
+	if !slices.Equal(got, []string{"CAN_VIEW_APP_QUALITY_GLOBAL", "BOGUS"}) {
+		t.Errorf("expected only the permissions that are not sensitive, got %v", got)
+	}
+}
+
+func TestWithCustomRoles(t *testing.T) {
+	roles, err := WithCustomRoles(map[string]Role{
+		"qa": {DeveloperAccountPermissions: []string{"CAN_MANAGE_TRACK_USERS_GLOBAL"}, AppLevelPermissions: []string{"CAN_MANAGE_TRACK_USERS"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := roles["qa"]; !ok {
+		t.Error("expected custom role to be added")
+	}
+	if _, ok := roles["release_manager"]; !ok {
+		t.Error("expected built-in roles to be kept")
+	}
+
+	if _, err := WithCustomRoles(map[string]Role{"admin": {}}); err == nil {
+		t.Error("expected redefining a built-in role to fail")
+	}
+	if _, err := WithCustomRoles(map[string]Role{"bad": {AppLevelPermissions: []string{"CAN_DO_ANYTHING"}}}); err == nil {
+		t.Error("expected unknown permissions to fail")
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+// Findings reported by the access review.
+const (
+	FindingExpired           = "expired"
+	FindingInvitationPending = "invitation_pending"
+	FindingAdmin             = "admin"
+	FindingFinancial         = "financial"
+	FindingNoAccess          = "no_access"
+	FindingExternalDomain    = "external_domain"
+)
+
+var adminPermissions = []string{"CAN_MANAGE_PERMISSIONS_GLOBAL", "CAN_MANAGE_PERMISSIONS"}
+
+var financialPermissions = []string{
+	"CAN_VIEW_FINANCIAL_DATA_GLOBAL",
+	"CAN_MANAGE_ORDERS_GLOBAL",
+	"CAN_VIEW_FINANCIAL_DATA",
+	"CAN_MANAGE_ORDERS",
+}
+
+// AccessReviewEntry is a single user of an access review report.
+type AccessReviewEntry struct {
+	Email                       string   `json:"email"`
+	AccessState                 string   `json:"access_state"`
+	PrincipalType               string   `json:"principal_type"`
+	ExpirationTime              string   `json:"expiration_time"`
+	DeveloperAccountPermissions []string `json:"developer_account_permissions"`
+	GrantedPackages             []string `json:"granted_packages"`
+	Findings                    []string `json:"findings"`
+}
+
+// AccessReviewer classifies users for an access review.
+type AccessReviewer struct {
+	// InternalDomains are the email domains considered internal. When empty,
+	// no user is reported as external.
+	InternalDomains []string
+	// GroupEmails are the addresses reported with principal type group.
+	GroupEmails []string
+	// PendingInvitationAge is how long an invitation must be pending to be
+	// reported.
+	PendingInvitationAge time.Duration
+	// InvitationTimes are the known send times of invitations, keyed by
+	// lowercased email. The API does not report them.
+	InvitationTimes map[string]time.Time
+	Now             time.Time
+}
+
+// holdsAny reports whether the user holds any of the permissions, either
+// across the developer account or through a grant.
+func holdsAny(user *androidpublisher.User, permissions []string) bool {
+	for _, permission := range permissions {
+		if slices.Contains(user.DeveloperAccountPermissions, permission) {
+			return true
+		}
+		for _, g := range user.Grants {
+			if slices.Contains(g.AppLevelPermissions, permission) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// invitationPending reports whether the invitation of the user has been
+// pending for at least PendingInvitationAge. Invitations without a known send
+// time are always reported, as their age cannot be told.
+func (r AccessReviewer) invitationPending(email string) bool {
+	sent, ok := r.InvitationTimes[strings.ToLower(email)]
+	return !ok || !r.Now.Before(sent.Add(r.PendingInvitationAge))
+}
+
+func (r AccessReviewer) isExternal(email string) bool {
+	if len(r.InternalDomains) == 0 {
+		return false
+	}
+	domain := strings.ToLower(email[strings.LastIndex(email, "@")+1:])
+	for _, internal := range r.InternalDomains {
+		internal = strings.ToLower(internal)
+		if domain == internal || strings.HasSuffix(domain, "."+internal) {
+			return false
+		}
+	}
+	return true
+}
+
+// Findings classifies a single user.
+func (r AccessReviewer) Findings(user *androidpublisher.User) []string {
+	findings := make([]string, 0)
+
+	expired := user.AccessState == AccessStateExpired || user.AccessState == AccessStateInvitationExpired
+	if user.ExpirationTime != "" {
+		if expiration, err := time.Parse(time.RFC3339, user.ExpirationTime); err == nil && expiration.Before(r.Now) {
+			expired = true
+		}
+	}
+	if expired {
+		findings = append(findings, FindingExpired)
+	}
+	if user.AccessState == AccessStateInvited && r.invitationPending(user.Email) {
+		findings = append(findings, FindingInvitationPending)
+	}
+	if holdsAny(user, adminPermissions) {
+		findings = append(findings, FindingAdmin)
+	}
+	if holdsAny(user, financialPermissions) {
+		findings = append(findings, FindingFinancial)
+	}
+	if len(user.Grants) == 0 && len(user.DeveloperAccountPermissions) == 0 {
+		findings = append(findings, FindingNoAccess)
+	}
+	if r.isExternal(user.Email) {
+		findings = append(findings, FindingExternalDomain)
+	}
+	return findings
+}
+
+// Review classifies every user, sorted by email.
+func (r AccessReviewer) Review(users []*androidpublisher.User) []AccessReviewEntry {
+	entries := make([]AccessReviewEntry, 0, len(users))
+	for _, user := range users {
+		packages := make([]string, 0, len(user.Grants))
+		for _, g := range user.Grants {
+			packages = append(packages, grantKey(g))
+		}
+		sort.Strings(packages)
+
+		permissions := slices.Clone(user.DeveloperAccountPermissions)
+		if permissions == nil {
+			permissions = make([]string, 0)
+		}
+
+		entries = append(entries, AccessReviewEntry{
+			Email:                       user.Email,
+			AccessState:                 user.AccessState,
+			PrincipalType:               InferPrincipalType(user.Email, r.GroupEmails),
+			ExpirationTime:              user.ExpirationTime,
+			DeveloperAccountPermissions: permissions,
+			GrantedPackages:             packages,
+			Findings:                    r.Findings(user),
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Email < entries[j].Email })
+	return entries
+}
+
+// AccessReviewJSON renders the entries as a JSON array.
+func AccessReviewJSON(entries []AccessReviewEntry) (string, error) {
+	data, err := json.MarshalIndent(entries, "", "  ")
+	return string(data), err
+}
+
+// AccessReviewCSV renders the entries as CSV with a header row. List values
+// are joined with semicolons.
+func AccessReviewCSV(entries []AccessReviewEntry) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	records := [][]string{{"email", "access_state", "principal_type", "expiration_time", "developer_account_permissions", "granted_packages", "findings"}}
+	for _, entry := range entries {
+		records = append(records, []string{
+			entry.Email,
+			entry.AccessState,
+			entry.PrincipalType,
+			entry.ExpirationTime,
+			strings.Join(entry.DeveloperAccountPermissions, ";"),
+			strings.Join(entry.GrantedPackages, ";"),
+			strings.Join(entry.Findings, ";"),
+		})
+	}
+	err := w.WriteAll(records)
+	return buf.String(), err
+}
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/lib"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AccessReviewDataSource{}
+
+func NewAccessReviewDataSource() datasource.DataSource {
+	return &AccessReviewDataSource{}
+}
+
+// AccessReviewDataSource defines the data source implementation.
+type AccessReviewDataSource struct {
+	*GoogleProviderContext
+}
+
+type AccessReviewData struct {
+	Email                       types.String `tfsdk:"email"`
+	AccessState                 types.String `tfsdk:"access_state"`
+	PrincipalType               types.String `tfsdk:"principal_type"`
+	ExpirationTime              types.String `tfsdk:"expiration_time"`
+	DeveloperAccountPermissions types.List   `tfsdk:"developer_account_permissions"`
+	GrantedPackages             types.List   `tfsdk:"granted_packages"`
+	Findings                    types.List   `tfsdk:"findings"`
+}
+
+// AccessReviewDataModel describes the data source data model.
+type AccessReviewDataModel struct {
+	DeveloperID           types.String       `tfsdk:"developer_id"`
+	InternalDomains       types.List         `tfsdk:"internal_domains"`
+	PendingInvitationDays types.Int64        `tfsdk:"pending_invitation_days"`
+	InvitationTimes       types.Map          `tfsdk:"invitation_times"`
+	Users                 []AccessReviewData `tfsdk:"users"`
+	JSON                  types.String       `tfsdk:"json"`
+	CSV                   types.String       `tfsdk:"csv"`
+}
+
+func (d *AccessReviewDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_review"
+}
+
+func (d *AccessReviewDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+
+		MarkdownDescription: "Classifies every user of a developer account for an access review. " +
+			"Findings are `expired` (access or invitation expired, or expiration time passed), `invitation_pending` (pending for at least `pending_invitation_days`), `admin`, `financial`, " +
+			"`no_access` (no grants and no developer account permissions) and `external_domain`.",
+
+		Attributes: map[string]schema.Attribute{
+			"developer_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the developer account",
+				Required:            true,
+			},
+			"internal_domains": schema.ListAttribute{
+				MarkdownDescription: "Email domains considered internal, including their subdomains. Users outside them are reported as `external_domain`. When unset, no user is reported as external.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"pending_invitation_days": schema.Int64Attribute{
+				MarkdownDescription: "The number of days an invitation must be pending to be reported as `invitation_pending`. The API does not report when an invitation was sent, so only invitations listed in `invitation_times` can be dated; every other pending invitation is reported. Defaults to 0, which reports every pending invitation.",
+				Optional:            true,
+			},
+			"invitation_times": schema.MapAttribute{
+				MarkdownDescription: "The RFC 3339 times invitations were sent, keyed by email, used to date invitations for `pending_invitation_days`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "The report as a JSON array, for writing with `local_file`",
+				Computed:            true,
+			},
+			"csv": schema.StringAttribute{
+				MarkdownDescription: "The report as CSV with a header row, for writing with `local_file`. List values are joined with semicolons.",
+				Computed:            true,
+			},
+
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "Every user of the developer account, sorted by email",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The user's email address",
+							Computed:            true,
+						},
+						"access_state": schema.StringAttribute{
+							MarkdownDescription: "The state of the user's access to the Play Console",
+							Computed:            true,
+						},
+						"principal_type": schema.StringAttribute{
+							MarkdownDescription: principalTypeDataDescription + " Separates group access from individual access.",
+							Computed:            true,
+						},
+						"expiration_time": schema.StringAttribute{
+							MarkdownDescription: "The time at which the user's access expires, or null if it never expires",
+							Computed:            true,
+						},
+						"developer_account_permissions": schema.ListAttribute{
+							MarkdownDescription: "The list of permissions granted to the user",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"granted_packages": schema.ListAttribute{
+							MarkdownDescription: "The package names of the apps the user has a grant for",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"findings": schema.ListAttribute{
+							MarkdownDescription: "The review findings for the user, empty if there are none",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *AccessReviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	gCtx, ok := req.ProviderData.(*GoogleProviderContext)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GoogleProviderContext, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.GoogleProviderContext = gCtx
+}
+
+func (d *AccessReviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AccessReviewDataModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	internalDomains, diags := lib.TFListToList[string](ctx, data.InternalDomains)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pendingInvitationDays := data.PendingInvitationDays.ValueInt64()
+	if pendingInvitationDays < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("pending_invitation_days"), "Invalid pending_invitation_days", fmt.Sprintf("Expected at least 0, got %d.", pendingInvitationDays))
+		return
+	}
+	invitationTimes := make(map[string]time.Time, len(data.InvitationTimes.Elements()))
+	var sentTimes map[string]string
+	resp.Diagnostics.Append(data.InvitationTimes.ElementsAs(ctx, &sentTimes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for email, sent := range sentTimes {
+		sentTime, err := time.Parse(time.RFC3339, sent)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("invitation_times").AtMapKey(email), "Invalid invitation time", fmt.Sprintf("Expected an RFC 3339 time, got %q.", sent))
+			return
+		}
+		invitationTimes[strings.ToLower(email)] = sentTime
+	}
+
+	users, err := d.ListUsers(ctx, data.DeveloperID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list users", err.Error())
+		return
+	}
+
+	reviewer := AccessReviewer{
+		InternalDomains:      internalDomains,
+		PendingInvitationAge: time.Duration(pendingInvitationDays) * 24 * time.Hour,
+		InvitationTimes:      invitationTimes,
+		Now:                  now(),
+	}
+	if d.GoogleProviderContext != nil {
+		reviewer.GroupEmails = d.GroupEmails
+	}
+	entries := reviewer.Review(users)
+
+	data.Users = make([]AccessReviewData, 0, len(entries))
+	for _, entry := range entries {
+		expirationTime := types.StringNull()
+		if entry.ExpirationTime != "" {
+			expirationTime = types.StringValue(entry.ExpirationTime)
+		}
+		data.Users = append(data.Users, AccessReviewData{
+			Email:                       types.StringValue(entry.Email),
+			AccessState:                 types.StringValue(entry.AccessState),
+			PrincipalType:               types.StringValue(entry.PrincipalType),
+			ExpirationTime:              expirationTime,
+			DeveloperAccountPermissions: lib.StrListToTfModel(entry.DeveloperAccountPermissions),
+			GrantedPackages:             lib.StrListToTfModel(entry.GrantedPackages),
+			Findings:                    lib.StrListToTfModel(entry.Findings),
+		})
+	}
+
+	report, err := AccessReviewJSON(entries)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to render access review", err.Error())
+		return
+	}
+	data.JSON = types.StringValue(report)
+
+	report, err = AccessReviewCSV(entries)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to render access review", err.Error())
+		return
+	}
+	data.CSV = types.StringValue(report)
+
+	tflog.Trace(ctx, "read access review data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+func TestAccAccessReviewDataSource(t *testing.T) {
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrSet("data.androidpublisher_access_review.test", "json"),
+		resource.TestCheckResourceAttrSet("data.androidpublisher_access_review.test", "csv"),
+		resource.TestCheckTypeSetElemNestedAttrs("data.androidpublisher_access_review.test", "users.*", map[string]string{
+			"email":                           env.TestEmail,
+			"developer_account_permissions.#": "1",
+			"developer_account_permissions.0": "CAN_VIEW_FINANCIAL_DATA_GLOBAL",
+			"granted_packages.#":              "0",
+		}),
+	}
+	if !testAccLive() {
+		// The fake API invites new users and seeds an owner holding every permission.
+		checks = append(checks,
+			resource.TestCheckResourceAttr("data.androidpublisher_access_review.test", "users.#", "2"),
+			resource.TestCheckTypeSetElemNestedAttrs("data.androidpublisher_access_review.test", "users.*", map[string]string{
+				"email":          fakeOwnerEmail,
+				"access_state":   AccessStateGranted,
+				"principal_type": PrincipalTypeUser,
+				"findings.#":     "2",
+				"findings.0":     FindingAdmin,
+				"findings.1":     FindingFinancial,
+			}),
+			resource.TestCheckTypeSetElemNestedAttrs("data.androidpublisher_access_review.test", "users.*", map[string]string{
+				"email":        env.TestEmail,
+				"access_state": AccessStateInvited,
+				"findings.#":   "2",
+				"findings.0":   FindingInvitationPending,
+				"findings.1":   FindingFinancial,
+			}),
+			resource.TestCheckNoResourceAttr("data.androidpublisher_access_review.test", "users.0.expiration_time"),
+			resource.TestCheckNoResourceAttr("data.androidpublisher_access_review.test", "users.1.expiration_time"),
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "androidpublisher_user" "test" {
+  email = %[1]q
+  developer_id = %[2]q
+  developer_account_permissions = ["CAN_VIEW_FINANCIAL_DATA_GLOBAL"]
+  deletion_protection = false
+}
+
+data "androidpublisher_access_review" "test" {
+  developer_id     = %[2]q
+  internal_domains = [split("@", %[1]q)[1]]
+
+  depends_on = [androidpublisher_user.test]
+}
+`, env.TestEmail, env.TestDeveloperId),
+				Check: resource.ComposeAggregateTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+func TestAccessReviewDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	play, gCtx := newTestFakePlay(t)
+	play.PutUser("123", &androidpublisher.User{Email: "a@example.com", AccessState: AccessStateGranted})
+	play.PutUser("123", &androidpublisher.User{Email: "b@example.com", AccessState: AccessStateGranted, ExpirationTime: "2999-01-01T00:00:00Z"})
+	d := &AccessReviewDataSource{GoogleProviderContext: gCtx}
+
+	config, state := testDataSourceRead(t, d, "123")
+	resp := datasource.ReadResponse{State: state}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	var data AccessReviewDataModel
+	if diags := resp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(data.Users) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(data.Users))
+	}
+	if !data.Users[0].ExpirationTime.IsNull() {
+		t.Errorf("expected a null expiration_time, got %s", data.Users[0].ExpirationTime)
+	}
+	if got := data.Users[1].ExpirationTime.ValueString(); got != "2999-01-01T00:00:00Z" {
+		t.Errorf("expected expiration_time 2999-01-01T00:00:00Z, got %q", got)
+	}
+}
+
+func TestAccessReviewDataSourcePendingInvitationDays(t *testing.T) {
+	ctx := context.Background()
+	setNow(t, time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC))
+	play, gCtx := newTestFakePlay(t)
+	play.PutUser("123", &androidpublisher.User{Email: "old@example.com", AccessState: AccessStateInvited})
+	play.PutUser("123", &androidpublisher.User{Email: "recent@example.com", AccessState: AccessStateInvited})
+	d := &AccessReviewDataSource{GoogleProviderContext: gCtx}
+
+	read := func(invitationTimes map[string]attr.Value) datasource.ReadResponse {
+		config, state := testDataSourceConfig(t, d, &AccessReviewDataModel{
+			DeveloperID:           types.StringValue("123"),
+			InternalDomains:       types.ListNull(types.StringType),
+			PendingInvitationDays: types.Int64Value(7),
+			InvitationTimes:       types.MapValueMust(types.StringType, invitationTimes),
+			JSON:                  types.StringNull(),
+			CSV:                   types.StringNull(),
+		})
+		resp := datasource.ReadResponse{State: state}
+		d.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+		return resp
+	}
+
+	resp := read(map[string]attr.Value{
+		"old@example.com":    types.StringValue("2030-01-01T00:00:00Z"),
+		"recent@example.com": types.StringValue("2030-01-08T00:00:00Z"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	var data AccessReviewDataModel
+	if diags := resp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+	pending := map[string]bool{}
+	for _, user := range data.Users {
+		var findings []string
+		user.Findings.ElementsAs(ctx, &findings, false)
+		pending[user.Email.ValueString()] = slices.Contains(findings, FindingInvitationPending)
+	}
+	if !pending["old@example.com"] || pending["recent@example.com"] {
+		t.Errorf("expected only the invitation older than 7 days to be pending, got %v", pending)
+	}
+
+	if resp := read(map[string]attr.Value{"old@example.com": types.StringValue("last week")}); !resp.Diagnostics.HasError() {
+		t.Error("expected an invalid invitation time to be rejected")
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+func TestAccessReviewerFindings(t *testing.T) {
+	reviewer := AccessReviewer{
+		InternalDomains: []string{"example.com"},
+		Now:             time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := map[string]struct {
+		user *androidpublisher.User
+		want []string
+	}{
+		"clean": {
+			&androidpublisher.User{Email: "dev@example.com", AccessState: AccessStateGranted, DeveloperAccountPermissions: []string{"CAN_VIEW_APP_QUALITY_GLOBAL"}},
+			[]string{},
+		},
+		"access expired": {
+			&androidpublisher.User{Email: "dev@example.com", AccessState: AccessStateExpired, DeveloperAccountPermissions: []string{"CAN_VIEW_APP_QUALITY_GLOBAL"}},
+			[]string{FindingExpired},
+		},
+		"expiration passed": {
+			&androidpublisher.User{Email: "dev@example.com", AccessState: AccessStateGranted, ExpirationTime: "2029-12-31T00:00:00Z", DeveloperAccountPermissions: []string{"CAN_VIEW_APP_QUALITY_GLOBAL"}},
+			[]string{FindingExpired},
+		},
+		"pending invitation without access": {
+			&androidpublisher.User{Email: "new@example.com", AccessState: AccessStateInvited},
+			[]string{FindingInvitationPending, FindingNoAccess},
+		},
+		"admin through grant": {
+			&androidpublisher.User{Email: "dev@example.com", AccessState: AccessStateGranted, Grants: []*androidpublisher.Grant{{PackageName: "com.example.app", AppLevelPermissions: []string{"CAN_MANAGE_PERMISSIONS"}}}},
+			[]string{FindingAdmin},
+		},
+		"financial": {
+			&androidpublisher.User{Email: "cfo@example.com", AccessState: AccessStateGranted, DeveloperAccountPermissions: []string{"CAN_VIEW_FINANCIAL_DATA_GLOBAL"}},
+			[]string{FindingFinancial},
+		},
+		"internal subdomain": {
+			&androidpublisher.User{Email: "bot@ci.example.com", AccessState: AccessStateGranted, DeveloperAccountPermissions: []string{"CAN_VIEW_APP_QUALITY_GLOBAL"}},
+			[]string{},
+		},
+		"external": {
+			&androidpublisher.User{Email: "contractor@agency.com", AccessState: AccessStateGranted, DeveloperAccountPermissions: []string{"CAN_VIEW_APP_QUALITY_GLOBAL"}},
+			[]string{FindingExternalDomain},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := reviewer.Findings(tt.user); !slices.Equal(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	if findings := (AccessReviewer{}).Findings(&androidpublisher.User{Email: "contractor@agency.com", DeveloperAccountPermissions: []string{"CAN_VIEW_APP_QUALITY_GLOBAL"}}); len(findings) != 0 {
+		t.Errorf("expected no external finding without internal domains, got %v", findings)
+	}
+}
+
+func TestAccessReviewReports(t *testing.T) {
+	entries := AccessReviewer{Now: time.Now()}.Review([]*androidpublisher.User{
+		{Email: "b@example.com", AccessState: AccessStateInvited},
+		{Email: "a@example.com", AccessState: AccessStateGranted, DeveloperAccountPermissions: []string{"CAN_MANAGE_PERMISSIONS_GLOBAL", "CAN_VIEW_FINANCIAL_DATA_GLOBAL"}},
+	})
+
+	report, err := AccessReviewJSON(entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded []AccessReviewEntry
+	if err := json.Unmarshal([]byte(report), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded) != 2 || decoded[0].Email != "a@example.com" {
+		t.Errorf("expected entries sorted by email, got %v", decoded)
+	}
+
+	report, err = AccessReviewCSV(entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(report), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected a header and two rows, got %q", report)
+	}
+	if lines[1] != "a@example.com,ACCESS_GRANTED,user,,CAN_MANAGE_PERMISSIONS_GLOBAL;CAN_VIEW_FINANCIAL_DATA_GLOBAL,,admin;financial" {
+		t.Errorf("unexpected row %q", lines[1])
+	}
+}
+
+func TestAccessReviewPrincipalTypes(t *testing.T) {
+	reviewer := AccessReviewer{GroupEmails: []string{"Team@example.com"}, Now: time.Now()}
+	entries := reviewer.Review([]*androidpublisher.User{
+		{Email: "team@example.com", AccessState: AccessStateGranted},
+		{Email: "ci@project.iam.gserviceaccount.com", AccessState: AccessStateGranted},
+		{Email: "person@example.com", AccessState: AccessStateGranted},
+	})
+
+	got := map[string]string{}
+	for _, entry := range entries {
+		got[entry.Email] = entry.PrincipalType
+	}
+	want := map[string]string{
+		"team@example.com":                   PrincipalTypeGroup,
+		"ci@project.iam.gserviceaccount.com": PrincipalTypeServiceAccount,
+		"person@example.com":                 PrincipalTypeUser,
+	}
+	for email, principalType := range want {
+		if got[email] != principalType {
+			t.Errorf("%s: expected %q, got %q", email, principalType, got[email])
+		}
+	}
+}
+
+func TestAccessReviewerPendingInvitationAge(t *testing.T) {
+	now := time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)
+	reviewer := AccessReviewer{
+		PendingInvitationAge: 7 * 24 * time.Hour,
+		InvitationTimes: map[string]time.Time{
+			"old@example.com":    now.AddDate(0, 0, -8),
+			"recent@example.com": now.AddDate(0, 0, -6),
+			"exact@example.com":  now.AddDate(0, 0, -7),
+		},
+		Now: now,
+	}
+
+	tests := map[string]bool{
+		"old@example.com":     true,
+		"Recent@example.com":  false,
+		"exact@example.com":   true,
+		"undated@example.com": true,
+	}
+	for email, want := range tests {
+		t.Run(email, func(t *testing.T) {
+			findings := reviewer.Findings(&androidpublisher.User{Email: email, AccessState: AccessStateInvited})
+			if got := slices.Contains(findings, FindingInvitationPending); got != want {
+				t.Errorf("expected invitation_pending %t, got %v", want, findings)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/permissions"
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+// AccessLoss describes the access a planned change takes away from a user.
+type AccessLoss struct {
+	Email string
+	// Removed are the developer account permissions the user loses.
+	Removed []string
+	// Deleted is true if the user is removed from the developer account.
+	Deleted bool
+}
+
+func (l AccessLoss) IsEmpty() bool {
+	return !l.Deleted && len(l.Removed) == 0
+}
+
+// RemovesAdmin reports whether the user loses the permission to manage other users.
+func (l AccessLoss) RemovesAdmin() bool {
+	return slices.Contains(l.Removed, permissions.ManagePermissionsGlobal)
+}
+
+// isActiveAdmin reports whether the user has accepted access and can manage other users.
+func isActiveAdmin(user *androidpublisher.User) bool {
+	return user.AccessState == AccessStateGranted && slices.Contains(user.DeveloperAccountPermissions, permissions.ManagePermissionsGlobal)
+}
+
+// LockoutReasons explains why the loss would lock the provider or everyone
+// else out of the developer account. users is the current user list and
+// callerEmail the identity the provider authenticates as, which may be empty.
+func LockoutReasons(users []*androidpublisher.User, callerEmail string, loss AccessLoss) []string {
+	var reasons []string
+	if loss.IsEmpty() {
+		return reasons
+	}
+
+	if callerEmail != "" && strings.EqualFold(loss.Email, callerEmail) {
+		if loss.Deleted {
+			reasons = append(reasons, fmt.Sprintf("%q is the identity the provider authenticates as and would be removed.", loss.Email))
+		} else {
+			reasons = append(reasons, fmt.Sprintf("%q is the identity the provider authenticates as and would lose %s.", loss.Email, strings.Join(loss.Removed, ", ")))
+		}
+	}
+
+	if !loss.RemovesAdmin() {
+		return reasons
+	}
+	var isAdmin, otherAdmin bool
+	for _, user := range users {
+		if !isActiveAdmin(user) {
+			continue
+		}
+		if strings.EqualFold(user.Email, loss.Email) {
+			isAdmin = true
+		} else {
+			otherAdmin = true
+		}
+	}
+	if isAdmin && !otherAdmin {
+		reasons = append(reasons, fmt.Sprintf("%q is the last user with %s.", loss.Email, permissions.ManagePermissionsGlobal))
+	}
+	return reasons
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/lib"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/permissions"
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+func TestLockoutReasons(t *testing.T) {
+	admin := func(email, accessState string) *androidpublisher.User {
+		return &androidpublisher.User{
+			Email:                       email,
+			AccessState:                 accessState,
+			DeveloperAccountPermissions: []string{permissions.ManagePermissionsGlobal},
+		}
+	}
+	removeAdmin := AccessLoss{Email: "a@example.com", Removed: []string{permissions.ManagePermissionsGlobal}}
+	deleteUser := AccessLoss{Email: "a@example.com", Removed: []string{permissions.ManagePermissionsGlobal}, Deleted: true}
+
+	tests := []struct {
+		name        string
+		users       []*androidpublisher.User
+		callerEmail string
+		loss        AccessLoss
+		expected    int
+	}{
+		{"no loss", []*androidpublisher.User{admin("a@example.com", AccessStateGranted)}, "a@example.com", AccessLoss{Email: "a@example.com"}, 0},
+		{"last admin", []*androidpublisher.User{admin("a@example.com", AccessStateGranted)}, "", removeAdmin, 1},
+		{"last admin deleted", []*androidpublisher.User{admin("a@example.com", AccessStateGranted)}, "", deleteUser, 1},
+		{"other admin", []*androidpublisher.User{admin("a@example.com", AccessStateGranted), admin("b@example.com", AccessStateGranted)}, "", removeAdmin, 0},
+		{"other admin only invited", []*androidpublisher.User{admin("a@example.com", AccessStateGranted), admin("b@example.com", AccessStateInvited)}, "", removeAdmin, 1},
+		{"not an active admin", []*androidpublisher.User{admin("a@example.com", AccessStateInvited)}, "", removeAdmin, 0},
+		{"caller downgraded", nil, "A@example.com", AccessLoss{Email: "a@example.com", Removed: []string{"CAN_VIEW_APP_QUALITY_GLOBAL"}}, 1},
+		{"caller last admin", []*androidpublisher.User{admin("a@example.com", AccessStateGranted)}, "a@example.com", deleteUser, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reasons := LockoutReasons(tt.users, tt.callerEmail, tt.loss)
+			if len(reasons) != tt.expected {
+				t.Errorf("expected %d reasons, got %v", tt.expected, reasons)
+			}
+		})
+	}
+}
+
+func TestUserResourceGuardsLastAdminDestroy(t *testing.T) {
+	ctx := context.Background()
+	fake, gCtx := newTestFakePlay(t)
+	fake.PutUser("123", &androidpublisher.User{
+		Email:                       "admin@example.com",
+		AccessState:                 AccessStateGranted,
+		DeveloperAccountPermissions: []string{permissions.ManagePermissionsGlobal},
+	})
+	r := &UserResource{GoogleProviderContext: gCtx}
+
+	destroy := func(allowAdminRemoval bool) resource.ModifyPlanResponse {
+		state := testUserState(t, r, func(m *UserResourceModel) {
+			m.Email = types.StringValue("admin@example.com")
+			m.DeveloperAccountPermissions = lib.StrListToTfModel([]string{permissions.ManagePermissionsGlobal})
+			m.AllowAdminRemoval = types.BoolValue(allowAdminRemoval)
+		})
+		plan := testResourcePlan(t, r, nil)
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, &resp)
+		return resp
+	}
+
+	if resp := destroy(false); !resp.Diagnostics.HasError() {
+		t.Error("expected destroying the last admin to fail")
+	}
+	if resp := destroy(true); resp.Diagnostics.HasError() {
+		t.Errorf("expected allow_admin_removal to permit the destroy, got %v", resp.Diagnostics)
+	}
+}
+
+func TestUserResourceGuardsAdminsRemovedInOnePlan(t *testing.T) {
+	ctx := context.Background()
+	fake, gCtx := newTestFakePlay(t)
+	r := &UserResource{GoogleProviderContext: gCtx}
+
+	states := map[string]tfsdk.State{}
+	for _, email := range []string{"a@example.com", "b@example.com"} {
+		fake.PutUser("123", &androidpublisher.User{
+			Email:                       email,
+			AccessState:                 AccessStateGranted,
+			DeveloperAccountPermissions: []string{permissions.ManagePermissionsGlobal},
+		})
+		states[email] = testUserState(t, r, func(m *UserResourceModel) {
+			m.Email = types.StringValue(email)
+			m.Name = types.StringValue("developers/123/users/" + email)
+			m.DeveloperAccountPermissions = lib.StrListToTfModel([]string{permissions.ManagePermissionsGlobal})
+		})
+	}
+
+	// Each destroy is planned against the listing before either is applied,
+	// so both pass.
+	plan := testResourcePlan(t, r, nil)
+	for email, state := range states {
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("expected destroying %s to be planned, got %v", email, resp.Diagnostics)
+		}
+	}
+
+	deleteResp := resource.DeleteResponse{State: states["a@example.com"]}
+	r.Delete(ctx, resource.DeleteRequest{State: states["a@example.com"]}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatal(deleteResp.Diagnostics)
+	}
+
+	deleteResp = resource.DeleteResponse{State: states["b@example.com"]}
+	r.Delete(ctx, resource.DeleteRequest{State: states["b@example.com"]}, &deleteResp)
+	if !deleteResp.Diagnostics.HasError() {
+		t.Error("expected deleting the last admin to be refused at apply time")
+	}
+	if users := fake.Users("123"); len(users) != 1 || users[0].Email != "b@example.com" {
+		t.Errorf("expected the last admin to be kept, got %v", users)
+	}
+
+	// Demoting the last admin is refused the same way.
+	demoted := testUserModel(func(m *UserResourceModel) {
+		m.Email = types.StringValue("b@example.com")
+		m.Name = types.StringValue("developers/123/users/b@example.com")
+	})
+	updateResp := resource.UpdateResponse{State: states["b@example.com"]}
+	r.Update(ctx, resource.UpdateRequest{Plan: testResourcePlan(t, r, demoted), State: states["b@example.com"]}, &updateResp)
+	if !updateResp.Diagnostics.HasError() {
+		t.Error("expected demoting the last admin to be refused at apply time")
+	}
+	if patches := testRequests(fake, http.MethodPatch); len(patches) != 0 {
+		t.Errorf("expected no patch, got %v", patches)
+	}
+}
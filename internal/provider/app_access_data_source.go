@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/lib"
+	"google.golang.org/api/androidpublisher/v3"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AppAccessDataSource{}
+
+func NewAppAccessDataSource() datasource.DataSource {
+	return &AppAccessDataSource{}
+}
+
+// AppAccessDataSource defines the data source implementation.
+type AppAccessDataSource struct {
+	*GoogleProviderContext
+}
+
+type AppAccessData struct {
+	Email                      types.String `tfsdk:"email"`
+	AccessState                types.String `tfsdk:"access_state"`
+	AppLevelPermissions        types.List   `tfsdk:"app_level_permissions"`
+	InheritedGlobalPermissions types.List   `tfsdk:"inherited_global_permissions"`
+}
+
+// AppAccessDataModel describes the data source data model.
+type AppAccessDataModel struct {
+	DeveloperID types.String    `tfsdk:"developer_id"`
+	PackageName types.String    `tfsdk:"package_name"`
+	Value       []AppAccessData `tfsdk:"value"`
+}
+
+func (d *AppAccessDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_access"
+}
+
+func (d *AppAccessDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+
+		MarkdownDescription: "Retrieves every user with access to a single app, either through a grant for the app or through developer account permissions.",
+
+		Attributes: map[string]schema.Attribute{
+			"developer_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the developer account",
+				Required:            true,
+			},
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app",
+				Required:            true,
+			},
+
+			"value": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of users with access to the app",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The user's email address",
+							Computed:            true,
+						},
+						"access_state": schema.StringAttribute{
+							MarkdownDescription: "The state of the user's access to the Play Console",
+							Computed:            true,
+						},
+						"app_level_permissions": schema.ListAttribute{
+							MarkdownDescription: "The list of app-level permissions granted to the user for this app",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"inherited_global_permissions": schema.ListAttribute{
+							MarkdownDescription: "The list of developer account permissions the user holds, which apply to every app",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *AppAccessDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	gCtx, ok := req.ProviderData.(*GoogleProviderContext)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GoogleProviderContext, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.GoogleProviderContext = gCtx
+}
+
+func (d *AppAccessDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AppAccessDataModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := d.ListUsers(ctx, data.DeveloperID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list users", err.Error())
+		return
+	}
+
+	entries := make([]AppAccessData, 0)
+	for _, user := range users {
+		entry, ok := UserToAppAccessData(*user, data.PackageName.ValueString())
+		if ok {
+			entries = append(entries, entry)
+		}
+	}
+
+	data.Value = entries
+
+	tflog.Trace(ctx, "read app access data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// UserToAppAccessData flattens the user's access to the given package. It
+// returns false if the user has neither a grant for the package nor any
+// developer account permissions.
+func UserToAppAccessData(user androidpublisher.User, packageName string) (AppAccessData, bool) {
+	appLevelPermissions := lib.StrListToTfModel(nil)
+	if g := findGrant(user.Grants, packageName); g != nil {
+		appLevelPermissions = lib.StrListToTfModel(g.AppLevelPermissions)
+	}
+
+	if len(appLevelPermissions.Elements()) == 0 && len(user.DeveloperAccountPermissions) == 0 {
+		return AppAccessData{}, false
+	}
+
+	return AppAccessData{
+		Email:                      types.StringValue(user.Email),
+		AccessState:                types.StringValue(user.AccessState),
+		AppLevelPermissions:        appLevelPermissions,
+		InheritedGlobalPermissions: lib.StrListToTfModel(user.DeveloperAccountPermissions),
+	}, true
+}
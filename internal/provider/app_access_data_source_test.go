@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAppAccessDataSource(t *testing.T) {
+	config := fmt.Sprintf(`
+data "androidpublisher_app_access" "test" {
+  developer_id = %q
+  package_name = %q
+}
+`, env.TestDeveloperId, env.TestPackageName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckPackageName(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.androidpublisher_app_access.test", "package_name", env.TestPackageName),
+					resource.TestCheckResourceAttrWith("data.androidpublisher_app_access.test", "value.#", testCheckResourceCountNotEmpty),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,441 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/names"
+
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AppAccessPolicyResource{}
+var _ resource.ResourceWithImportState = &AppAccessPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &AppAccessPolicyResource{}
+
+// AppAccessPolicyResource manages every grant for a single app.
+type AppAccessPolicyResource struct {
+	*GoogleProviderContext
+}
+
+// AppAccessPolicyResourceModel describes the resource data model.
+type AppAccessPolicyResourceModel struct {
+	DeveloperID         types.String `tfsdk:"developer_id"`
+	PackageName         types.String `tfsdk:"package_name"`
+	AppLevelPermissions types.Map    `tfsdk:"app_level_permissions"`
+	Grants              types.List   `tfsdk:"grants"`
+	DeletionProtection  types.Bool   `tfsdk:"deletion_protection"`
+}
+
+func (m *AppAccessPolicyResourceModel) description() string {
+	return fmt.Sprintf("the access policy of %q", m.PackageName.ValueString())
+}
+
+func (m *AppAccessPolicyResourceModel) GetAppLevelPermissions(ctx context.Context) (map[string][]string, diag.Diagnostics) {
+	var permissions map[string][]string
+	diags := m.AppLevelPermissions.ElementsAs(ctx, &permissions, false)
+	return permissions, diags
+}
+
+// SetFromUsers reads back the grants every user holds for the package.
+func (m *AppAccessPolicyResourceModel) SetFromUsers(ctx context.Context, users []*androidpublisher.User) diag.Diagnostics {
+	grants := PackageGrants(users, m.PackageName.ValueString())
+
+	permissions := make(map[string][]string, len(grants))
+	list := make([]*androidpublisher.Grant, 0, len(grants))
+	for _, g := range grants {
+		permissions[g.Email] = g.Grant.AppLevelPermissions
+		list = append(list, g.Grant)
+	}
+
+	value, diags := types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, permissions)
+	m.AppLevelPermissions = value
+	grantList, d := grantsToList(ctx, list)
+	diags.Append(d...)
+	m.Grants = grantList
+	return diags
+}
+
+// UserGrant is a user's grant for a single package.
+type UserGrant struct {
+	Email string
+	Grant *androidpublisher.Grant
+}
+
+// PackageGrants returns the grants the users hold for the package, sorted by email.
+func PackageGrants(users []*androidpublisher.User, packageName string) []UserGrant {
+	var grants []UserGrant
+	for _, user := range users {
+		if g := findGrant(user.Grants, packageName); g != nil {
+			grants = append(grants, UserGrant{Email: user.Email, Grant: g})
+		}
+	}
+	sort.Slice(grants, func(i, j int) bool { return grants[i].Email < grants[j].Email })
+	return grants
+}
+
+func NewAppAccessPolicyResource() resource.Resource {
+	return &AppAccessPolicyResource{}
+}
+
+func (r *AppAccessPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_access_policy"
+}
+
+func (r *AppAccessPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manages the grants for a single app. Grants for the app held by users that are not listed are revoked on apply. Developer account permissions are never changed.",
+
+		Attributes: map[string]schema.Attribute{
+			"developer_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the developer account",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"app_level_permissions": schema.MapAttribute{
+				ElementType:         types.SetType{ElemType: types.StringType},
+				Required:            true,
+				MarkdownDescription: "The app-level permissions for the app, keyed by user email. Every user must already exist in the developer account.",
+			},
+			"deletion_protection": deletionProtectionAttribute(),
+			"grants": schema.ListNestedAttribute{
+				MarkdownDescription: "The grants for the app after apply",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the grant",
+							Computed:            true,
+						},
+						"package_name": schema.StringAttribute{
+							MarkdownDescription: "The package name of the app for which the user has access",
+							Computed:            true,
+						},
+						"app_level_permissions": schema.ListAttribute{
+							MarkdownDescription: "The list of app-level permissions granted to the user",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+				Computed: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AppAccessPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	gCtx, ok := req.ProviderData.(*GoogleProviderContext)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GoogleProviderContext, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.GoogleProviderContext = gCtx
+}
+
+func (r *AppAccessPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var state AppAccessPolicyResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if req.Plan.Raw.IsNull() {
+		// Destroying the policy revokes every declared grant for the app.
+		resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "destroy", state.description())...)
+		return
+	}
+
+	var data AppAccessPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !req.State.Raw.IsNull() && (!data.DeveloperID.Equal(state.DeveloperID) || !data.PackageName.Equal(state.PackageName)) {
+		resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "replace", state.description())...)
+	}
+	if !req.State.Raw.IsNull() && !data.AppLevelPermissions.Equal(state.AppLevelPermissions) {
+		// The grants are only known after the new permissions are applied.
+		data.Grants = types.ListUnknown(grantType)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+	}
+	if resp.Diagnostics.HasError() || !req.Plan.Raw.IsFullyKnown() || r.GoogleProviderContext == nil {
+		return
+	}
+	desired, diags := data.GetAppLevelPermissions(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := r.ListUsers(ctx, data.DeveloperID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list users", err.Error())
+		return
+	}
+	current := make(map[string][]string)
+	var revoked []string
+	for _, g := range PackageGrants(users, data.PackageName.ValueString()) {
+		current[g.Email] = g.Grant.AppLevelPermissions
+		if _, ok := desired[g.Email]; !ok {
+			revoked = append(revoked, fmt.Sprintf("%s (%s)", g.Email, strings.Join(g.Grant.AppLevelPermissions, ", ")))
+		}
+	}
+	if len(revoked) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Unmanaged grants will be revoked",
+			fmt.Sprintf("The following users hold grants for %q but are not declared in app_level_permissions, so their grants will be revoked on apply:\n  - %s", data.PackageName.ValueString(), strings.Join(revoked, "\n  - ")),
+		)
+	}
+
+	emails := make([]string, 0, len(desired))
+	for email := range desired {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+	changes := make([]PermissionChange, 0, len(emails))
+	for _, email := range emails {
+		changes = append(changes, DiffPermissions(email, data.PackageName.ValueString(), current[email], desired[email]))
+	}
+	resp.Diagnostics.Append(r.CheckEscalations(changes)...)
+}
+
+func (r *AppAccessPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AppAccessPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created an app access policy resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppAccessPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppAccessPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := r.ListUsers(ctx, data.DeveloperID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading app access policy", fmt.Sprintf("Unable to list users: %v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.SetFromUsers(ctx, users)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppAccessPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AppAccessPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated an app access policy resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppAccessPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AppAccessPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkDeletionProtection(data.DeletionProtection, "destroy", data.description())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	declared, diags := data.GetAppLevelPermissions(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	developerID := data.DeveloperID.ValueString()
+	r.InvalidateUsers(developerID)
+
+	users, err := r.ListUsers(ctx, developerID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting app access policy", fmt.Sprintf("Unable to list users: %v", err))
+		return
+	}
+
+	for _, g := range PackageGrants(users, data.PackageName.ValueString()) {
+		if _, ok := declared[g.Email]; !ok {
+			continue
+		}
+		err := r.Mutate(ctx, developerID, func() error {
+			return r.Grants.Delete(ctx, g.Grant.Name)
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error deleting grant", fmt.Sprintf("Unable to delete grant %q: %v", g.Grant.Name, err))
+			return
+		}
+	}
+}
+
+func (r *AppAccessPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	developerID, packageName, ok := strings.Cut(req.ID, "/")
+	if !ok || developerID == "" || packageName == "" {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected import ID in the format \"{developer_id}/{package_name}\", got %q", req.ID))
+		return
+	}
+	if err := errors.Join(names.ValidateDeveloperID(developerID), names.ValidatePackageName(packageName)); err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("developer_id"), developerID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_name"), packageName)...)
+}
+
+// apply creates, patches and revokes grants until the grants for the package
+// match the declared permissions, then reads the grants back into data.
+func (r *AppAccessPolicyResource) apply(ctx context.Context, data *AppAccessPolicyResourceModel) diag.Diagnostics {
+	desired, diags := data.GetAppLevelPermissions(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	developerID := data.DeveloperID.ValueString()
+	packageName := data.PackageName.ValueString()
+	r.InvalidateUsers(developerID)
+
+	users, err := r.ListUsers(ctx, developerID)
+	if err != nil {
+		diags.AddError("Failed to list users", err.Error())
+		return diags
+	}
+
+	members := make(map[string]*androidpublisher.User, len(users))
+	for _, user := range users {
+		members[user.Email] = user
+	}
+	var missing []string
+	for email := range desired {
+		if _, ok := members[email]; !ok {
+			missing = append(missing, email)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		diags.AddAttributeError(
+			path.Root("app_level_permissions"),
+			"Unknown users",
+			fmt.Sprintf("The following users are not members of developer account %q and must be added, e.g. with androidpublisher_user, before they can be granted access: %s", developerID, strings.Join(missing, ", ")),
+		)
+		return diags
+	}
+
+	for _, user := range users {
+		current := findGrant(user.Grants, packageName)
+		permissions, declared := desired[user.Email]
+		switch {
+		case current == nil && declared:
+			g := &androidpublisher.Grant{
+				Name:                names.Grant{DeveloperID: developerID, Email: user.Email, PackageName: packageName}.String(),
+				PackageName:         packageName,
+				AppLevelPermissions: permissions,
+			}
+			err := r.Mutate(ctx, developerID, func() error {
+				_, err := r.Grants.Create(ctx, user.Name, g)
+				return err
+			})
+			if err != nil {
+				diags.AddError("Error creating grant", fmt.Sprintf("Unable to create grant for %q: %v", user.Email, err))
+				return diags
+			}
+		case current != nil && !declared:
+			err := r.Mutate(ctx, developerID, func() error {
+				return r.Grants.Delete(ctx, current.Name)
+			})
+			if err != nil {
+				diags.AddError("Error deleting grant", fmt.Sprintf("Unable to revoke grant for %q: %v", user.Email, err))
+				return diags
+			}
+			tflog.Debug(ctx, "revoked unmanaged grant", map[string]interface{}{"email": user.Email, "package_name": packageName})
+		case current != nil && !sameElements(current.AppLevelPermissions, permissions):
+			g := &androidpublisher.Grant{AppLevelPermissions: permissions}
+			err := r.Mutate(ctx, developerID, func() error {
+				_, err := r.Grants.Patch(ctx, current.Name, g, "appLevelPermissions")
+				return err
+			})
+			if err != nil {
+				diags.AddError("Error updating grant", fmt.Sprintf("Unable to update grant for %q: %v", user.Email, err))
+				return diags
+			}
+		}
+	}
+
+	users, err = r.ListUsers(ctx, developerID)
+	if err != nil {
+		diags.AddError("Failed to list users", err.Error())
+		return diags
+	}
+	var grants []*androidpublisher.Grant
+	for _, g := range PackageGrants(users, packageName) {
+		grants = append(grants, g.Grant)
+	}
+	grantList, d := grantsToList(ctx, grants)
+	diags.Append(d...)
+	data.Grants = grantList
+	return diags
+}
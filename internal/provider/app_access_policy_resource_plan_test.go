@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+func TestAppAccessPolicyResourcePlanGrants(t *testing.T) {
+	ctx := context.Background()
+	r := &AppAccessPolicyResource{}
+	grants, diags := grantsToList(ctx, []*androidpublisher.Grant{{
+		Name:                "developers/123/users/user@example.com/grants/com.example.app",
+		PackageName:         "com.example.app",
+		AppLevelPermissions: []string{"CAN_REPLY_TO_REVIEWS"},
+	}})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	// model is a policy whose grants are kept from state, as planned by
+	// UseStateForUnknown.
+	model := func(permissions ...string) *AppAccessPolicyResourceModel {
+		values := make([]attr.Value, 0, len(permissions))
+		for _, permission := range permissions {
+			values = append(values, types.StringValue(permission))
+		}
+		return &AppAccessPolicyResourceModel{
+			DeveloperID: types.StringValue("123"),
+			PackageName: types.StringValue("com.example.app"),
+			AppLevelPermissions: types.MapValueMust(types.SetType{ElemType: types.StringType}, map[string]attr.Value{
+				"user@example.com": types.SetValueMust(types.StringType, values),
+			}),
+			Grants:             grants,
+			DeletionProtection: types.BoolValue(false),
+		}
+	}
+
+	tests := map[string]struct {
+		plan            *AppAccessPolicyResourceModel
+		expectedUnknown bool
+	}{
+		"unchanged permissions": {plan: model("CAN_REPLY_TO_REVIEWS"), expectedUnknown: false},
+		"changed permissions":   {plan: model("CAN_REPLY_TO_REVIEWS", "CAN_VIEW_APP_QUALITY"), expectedUnknown: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			state := testResourceState(t, r, model("CAN_REPLY_TO_REVIEWS"))
+			plan := testResourcePlan(t, r, tt.plan)
+
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			var data AppAccessPolicyResourceModel
+			if diags := resp.Plan.Get(ctx, &data); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if data.Grants.IsUnknown() != tt.expectedUnknown {
+				t.Errorf("expected grants unknown %t, got %s", tt.expectedUnknown, data.Grants)
+			}
+		})
+	}
+}
+
+func TestAppAccessPolicyResourcePlanWarnsAboutRevokedGrants(t *testing.T) {
+	ctx := context.Background()
+	fake, gCtx := newTestFakePlay(t)
+	for _, email := range []string{"managed@example.com", "unmanaged@example.com"} {
+		fake.PutUser("123", &androidpublisher.User{
+			Email:       email,
+			AccessState: AccessStateGranted,
+			Grants:      []*androidpublisher.Grant{{PackageName: fakePackageName, AppLevelPermissions: []string{"CAN_REPLY_TO_REVIEWS"}}},
+		})
+	}
+	r := &AppAccessPolicyResource{GoogleProviderContext: gCtx}
+
+	planned := &AppAccessPolicyResourceModel{
+		DeveloperID: types.StringValue("123"),
+		PackageName: types.StringValue(fakePackageName),
+		AppLevelPermissions: types.MapValueMust(types.SetType{ElemType: types.StringType}, map[string]attr.Value{
+			"managed@example.com": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("CAN_REPLY_TO_REVIEWS")}),
+		}),
+		Grants:             types.ListNull(grantType),
+		DeletionProtection: types.BoolValue(false),
+	}
+	plan := testResourcePlan(t, r, planned)
+	resp := resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: testResourceState(t, r, nil), Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 || warnings[0].Summary() != "Unmanaged grants will be revoked" {
+		t.Fatalf("expected a revoked grants warning, got %v", resp.Diagnostics)
+	}
+	if detail := warnings[0].Detail(); !strings.Contains(detail, "- unmanaged@example.com (CAN_REPLY_TO_REVIEWS)") || strings.Contains(detail, "- managed@example.com") {
+		t.Errorf("expected only the unmanaged grant to be listed, got %q", detail)
+	}
+}
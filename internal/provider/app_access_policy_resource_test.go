@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccAppAccessPolicyResource revokes every grant for TEST_PACKAGE_NAME
+// that is not held by TEST_EMAIL, so it must run against a dedicated test app.
+func TestAccAppAccessPolicyResource(t *testing.T) {
+	config := func(permissions string) string {
+		return fmt.Sprintf(`
+resource "androidpublisher_user" "test" {
+  email = %[1]q
+  developer_id = %[2]q
+  developer_account_permissions = [ "CAN_VIEW_APP_QUALITY_GLOBAL"]
+  deletion_protection = false
+}
+
+resource "androidpublisher_app_access_policy" "test" {
+  developer_id = %[2]q
+  package_name = %[3]q
+  deletion_protection = false
+
+  app_level_permissions = {
+    (androidpublisher_user.test.email) = %[4]s
+  }
+}
+`, env.TestEmail, env.TestDeveloperId, env.TestPackageName, permissions)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckPackageName(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`["CAN_REPLY_TO_REVIEWS"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("androidpublisher_app_access_policy.test", "app_level_permissions.%", "1"),
+					resource.TestCheckResourceAttr("androidpublisher_app_access_policy.test", "grants.#", "1"),
+					resource.TestCheckResourceAttr("androidpublisher_app_access_policy.test", "grants.0.package_name", env.TestPackageName),
+				),
+			},
+			{
+				ResourceName:      "androidpublisher_app_access_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s/%s", env.TestDeveloperId, env.TestPackageName),
+				// deletion_protection is not returned by the API.
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+			},
+			{
+				Config: config(`["CAN_REPLY_TO_REVIEWS", "CAN_VIEW_APP_QUALITY"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("androidpublisher_app_access_policy.test", "grants.0.app_level_permissions.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
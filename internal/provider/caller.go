@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+// credentialsFile holds the fields of a credentials JSON file that identify the caller.
+type credentialsFile struct {
+	ClientEmail                    string `json:"client_email"`
+	ServiceAccountImpersonationURL string `json:"service_account_impersonation_url"`
+}
+
+// DetectCallerEmail returns the email of the identity in the application default
+// credentials, or an empty string if it cannot be determined, e.g. for gcloud
+// user credentials or credentials from the metadata server.
+func DetectCallerEmail(ctx context.Context) string {
+	creds, err := google.FindDefaultCredentials(ctx, androidpublisher.AndroidpublisherScope)
+	if err != nil || creds.JSON == nil {
+		return ""
+	}
+	return callerEmailFromJSON(creds.JSON)
+}
+
+func callerEmailFromJSON(data []byte) string {
+	var file credentialsFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return ""
+	}
+	if file.ServiceAccountImpersonationURL != "" {
+		// https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/{email}:generateAccessToken
+		_, account, ok := strings.Cut(file.ServiceAccountImpersonationURL, "/serviceAccounts/")
+		if ok {
+			email, _, _ := strings.Cut(account, ":")
+			return email
+		}
+	}
+	return file.ClientEmail
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestCallerEmailFromJSON(t *testing.T) {
+	tests := map[string]struct {
+		json string
+		want string
+	}{
+		"service account key": {
+			json: `{"type": "service_account", "client_email": "terraform@project.iam.gserviceaccount.com"}`,
+			want: "terraform@project.iam.gserviceaccount.com",
+		},
+		"impersonated service account": {
+			json: `{"type": "impersonated_service_account", "service_account_impersonation_url": "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/publisher@project.iam.gserviceaccount.com:generateAccessToken"}`,
+			want: "publisher@project.iam.gserviceaccount.com",
+		},
+		"authorized user": {
+			json: `{"type": "authorized_user", "client_id": "id", "refresh_token": "token"}`,
+			want: "",
+		},
+		"invalid json": {
+			json: `{`,
+			want: "",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := callerEmailFromJSON([]byte(tt.json)); got != tt.want {
+				t.Errorf("callerEmailFromJSON() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
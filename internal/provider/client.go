@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+// UsersClient is the part of the Users API the provider calls. Names and
+// parents are resource names built with the names package.
+type UsersClient interface {
+	// List calls fn for every page of users of the parent developer account.
+	List(ctx context.Context, parent string, pageSize int64, fn func(*androidpublisher.ListUsersResponse) error) error
+	Create(ctx context.Context, parent string, user *androidpublisher.User) (*androidpublisher.User, error)
+	Patch(ctx context.Context, name string, user *androidpublisher.User, updateMask string) (*androidpublisher.User, error)
+	Delete(ctx context.Context, name string) error
+}
+
+// GrantsClient is the part of the Grants API the provider calls. Grants are
+// read through UsersClient.List, as the API has no method to get a grant.
+type GrantsClient interface {
+	Create(ctx context.Context, parent string, grant *androidpublisher.Grant) (*androidpublisher.Grant, error)
+	Patch(ctx context.Context, name string, grant *androidpublisher.Grant, updateMask string) (*androidpublisher.Grant, error)
+	Delete(ctx context.Context, name string) error
+}
+
+// NewUsersClient returns a UsersClient that calls the API through the service.
+func NewUsersClient(service *androidpublisher.Service) UsersClient {
+	return serviceUsersClient{service.Users}
+}
+
+// NewGrantsClient returns a GrantsClient that calls the API through the service.
+func NewGrantsClient(service *androidpublisher.Service) GrantsClient {
+	return serviceGrantsClient{service.Grants}
+}
+
+type serviceUsersClient struct {
+	users *androidpublisher.UsersService
+}
+
+func (c serviceUsersClient) List(ctx context.Context, parent string, pageSize int64, fn func(*androidpublisher.ListUsersResponse) error) error {
+	return c.users.List(parent).PageSize(pageSize).Pages(ctx, fn)
+}
+
+func (c serviceUsersClient) Create(ctx context.Context, parent string, user *androidpublisher.User) (*androidpublisher.User, error) {
+	return c.users.Create(parent, user).Context(ctx).Do()
+}
+
+func (c serviceUsersClient) Patch(ctx context.Context, name string, user *androidpublisher.User, updateMask string) (*androidpublisher.User, error) {
+	return c.users.Patch(name, user).UpdateMask(updateMask).Context(ctx).Do()
+}
+
+func (c serviceUsersClient) Delete(ctx context.Context, name string) error {
+	return c.users.Delete(name).Context(ctx).Do()
+}
+
+type serviceGrantsClient struct {
+	grants *androidpublisher.GrantsService
+}
+
+func (c serviceGrantsClient) Create(ctx context.Context, parent string, grant *androidpublisher.Grant) (*androidpublisher.Grant, error) {
+	return c.grants.Create(parent, grant).Context(ctx).Do()
+}
+
+func (c serviceGrantsClient) Patch(ctx context.Context, name string, grant *androidpublisher.Grant, updateMask string) (*androidpublisher.Grant, error) {
+	return c.grants.Patch(name, grant).UpdateMask(updateMask).Context(ctx).Do()
+}
+
+func (c serviceGrantsClient) Delete(ctx context.Context, name string) error {
+	return c.grants.Delete(name).Context(ctx).Do()
+}
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/apimodel"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/fakeplay"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/lib"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/timetypes"
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+func TestGrantResourceRequests(t *testing.T) {
+	ctx := context.Background()
+	const grantName = "developers/123/users/user@example.com/grants/com.example.app"
+
+	model := func(permissions ...string) *GrantResourceModel {
+		return &GrantResourceModel{
+			GrantModel: apimodel.GrantModel{
+				AppLevelPermissions: lib.StrListToTfModel(permissions),
+				Name:                types.StringUnknown(),
+				PackageName:         types.StringValue("com.example.app"),
+			},
+			DeveloperID:        types.StringValue("123"),
+			Email:              types.StringValue("user@example.com"),
+			DeletionProtection: types.BoolValue(false),
+		}
+	}
+
+	created := model("CAN_REPLY_TO_REVIEWS")
+	created.Name = types.StringValue(grantName)
+	updated := model("CAN_REPLY_TO_REVIEWS", "CAN_VIEW_APP_QUALITY")
+	updated.Name = types.StringValue(grantName)
+
+	tests := map[string]struct {
+		grants   []*androidpublisher.Grant
+		run      func(r *GrantResource) bool
+		expected []fakeplay.Request
+	}{
+		"create": {
+			run: func(r *GrantResource) bool {
+				resp := resource.CreateResponse{State: testResourceState(t, r, nil)}
+				r.Create(ctx, resource.CreateRequest{Plan: testResourcePlan(t, r, model("CAN_REPLY_TO_REVIEWS"))}, &resp)
+				return !resp.Diagnostics.HasError()
+			},
+			expected: []fakeplay.Request{{
+				Method: http.MethodPost,
+				Name:   "developers/123/users/user@example.com/grants",
+				Body: map[string]interface{}{
+					"name":                grantName,
+					"packageName":         "com.example.app",
+					"appLevelPermissions": []interface{}{"CAN_REPLY_TO_REVIEWS"},
+				},
+			}},
+		},
+		"update": {
+			grants: []*androidpublisher.Grant{{PackageName: "com.example.app", AppLevelPermissions: []string{"CAN_REPLY_TO_REVIEWS"}}},
+			run: func(r *GrantResource) bool {
+				resp := resource.UpdateResponse{State: testResourceState(t, r, created)}
+				r.Update(ctx, resource.UpdateRequest{Plan: testResourcePlan(t, r, updated), State: testResourceState(t, r, created)}, &resp)
+				return !resp.Diagnostics.HasError()
+			},
+			expected: []fakeplay.Request{{
+				Method:     http.MethodPatch,
+				Name:       grantName,
+				UpdateMask: "appLevelPermissions",
+				Body: map[string]interface{}{
+					"appLevelPermissions": []interface{}{"CAN_REPLY_TO_REVIEWS", "CAN_VIEW_APP_QUALITY"},
+				},
+			}},
+		},
+		"delete": {
+			grants: []*androidpublisher.Grant{{PackageName: "com.example.app", AppLevelPermissions: []string{"CAN_REPLY_TO_REVIEWS"}}},
+			run: func(r *GrantResource) bool {
+				resp := resource.DeleteResponse{State: testResourceState(t, r, created)}
+				r.Delete(ctx, resource.DeleteRequest{State: testResourceState(t, r, created)}, &resp)
+				return !resp.Diagnostics.HasError()
+			},
+			expected: []fakeplay.Request{{Method: http.MethodDelete, Name: grantName}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			play, gCtx := newTestFakePlay(t)
+			play.PutUser("123", &androidpublisher.User{Email: "user@example.com", AccessState: AccessStateGranted, Grants: tt.grants})
+			r := &GrantResource{GoogleProviderContext: gCtx}
+			if !tt.run(r) {
+				t.Fatal("unexpected error diagnostics")
+			}
+			if requests := testRequests(play, http.MethodPost, http.MethodPatch, http.MethodDelete); !reflect.DeepEqual(requests, tt.expected) {
+				t.Errorf("expected requests %+v, got %+v", tt.expected, requests)
+			}
+		})
+	}
+}
+
+func TestUserResourceRequests(t *testing.T) {
+	ctx := context.Background()
+	const userName = "developers/123/users/user@example.com"
+
+	created := testUserModel(func(m *UserResourceModel) {
+		m.Email = types.StringValue("user@example.com")
+		m.Name = types.StringValue(userName)
+		m.ExpirationTime = timetypes.NewRFC3339Value("2999-01-01T00:00:00Z")
+	})
+	updated := *created
+	updated.ExpirationTime = timetypes.NewRFC3339Null()
+	updated.DeveloperAccountPermissions = lib.StrListToTfModel([]string{"CAN_VIEW_APP_QUALITY_GLOBAL", "CAN_REPLY_TO_REVIEWS_GLOBAL"})
+
+	tests := map[string]struct {
+		existing bool
+		run      func(r *UserResource) bool
+		expected []fakeplay.Request
+	}{
+		"create": {
+			run: func(r *UserResource) bool {
+				plan := *created
+				plan.AccessState = types.StringUnknown()
+				plan.Grants = types.ListUnknown(grantType)
+				plan.Name = types.StringUnknown()
+				resp := resource.CreateResponse{State: testResourceState(t, r, nil)}
+				r.Create(ctx, resource.CreateRequest{Plan: testResourcePlan(t, r, &plan)}, &resp)
+				return !resp.Diagnostics.HasError()
+			},
+			expected: []fakeplay.Request{{
+				Method: http.MethodPost,
+				Name:   "developers/123/users",
+				Body: map[string]interface{}{
+					"name":                        userName,
+					"email":                       "user@example.com",
+					"developerAccountPermissions": []interface{}{"CAN_VIEW_APP_QUALITY_GLOBAL"},
+					"expirationTime":              "2999-01-01T00:00:00Z",
+				},
+			}},
+		},
+		"update": {
+			existing: true,
+			run: func(r *UserResource) bool {
+				resp := resource.UpdateResponse{State: testResourceState(t, r, created)}
+				r.Update(ctx, resource.UpdateRequest{Plan: testResourcePlan(t, r, &updated), State: testResourceState(t, r, created)}, &resp)
+				return !resp.Diagnostics.HasError()
+			},
+			expected: []fakeplay.Request{{
+				Method:     http.MethodPatch,
+				Name:       userName,
+				UpdateMask: "developerAccountPermissions,expirationTime",
+				Body: map[string]interface{}{
+					"developerAccountPermissions": []interface{}{"CAN_VIEW_APP_QUALITY_GLOBAL", "CAN_REPLY_TO_REVIEWS_GLOBAL"},
+					"expirationTime":              nil,
+				},
+			}},
+		},
+		"delete": {
+			existing: true,
+			run: func(r *UserResource) bool {
+				resp := resource.DeleteResponse{State: testResourceState(t, r, created)}
+				r.Delete(ctx, resource.DeleteRequest{State: testResourceState(t, r, created)}, &resp)
+				return !resp.Diagnostics.HasError()
+			},
+			expected: []fakeplay.Request{{Method: http.MethodDelete, Name: userName}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			play, gCtx := newTestFakePlay(t)
+			if tt.existing {
+				play.PutUser("123", &androidpublisher.User{
+					Email:                       "user@example.com",
+					AccessState:                 AccessStateGranted,
+					DeveloperAccountPermissions: []string{"CAN_VIEW_APP_QUALITY_GLOBAL"},
+					ExpirationTime:              "2999-01-01T00:00:00Z",
+				})
+			}
+			r := &UserResource{GoogleProviderContext: gCtx}
+			if !tt.run(r) {
+				t.Fatal("unexpected error diagnostics")
+			}
+			if requests := testRequests(play, http.MethodPost, http.MethodPatch, http.MethodDelete); !reflect.DeepEqual(requests, tt.expected) {
+				t.Errorf("expected requests %+v, got %+v", tt.expected, requests)
+			}
+		})
+	}
+}
+
+func TestUserDataSourceRequests(t *testing.T) {
+	ctx := context.Background()
+	play, gCtx := newTestFakePlay(t)
+	play.PutUser("123", &androidpublisher.User{Email: "a@example.com", AccessState: AccessStateGranted})
+	d := &UserDataSource{GoogleProviderContext: gCtx}
+
+	config, state := testDataSourceRead(t, d, "123")
+	resp := datasource.ReadResponse{State: state}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	expected := []fakeplay.Request{{Method: http.MethodGet, Name: "developers/123/users"}}
+	if requests := play.Requests(); !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %+v, got %+v", expected, requests)
+	}
+
+	var data UserDataModel
+	if diags := resp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(data.Value) != 1 || data.Value[0].Email.ValueString() != "a@example.com" {
+		t.Errorf("expected the listed user to be read, got %+v", data.Value)
+	}
+}
+
+func TestForEachUserUsesClient(t *testing.T) {
+	play, c := newTestFakePlay(t)
+	play.PutUser("123", &androidpublisher.User{Email: "a@example.com"})
+	play.PutUser("123", &androidpublisher.User{Email: "b@example.com"})
+
+	user, err := c.FindUser(context.Background(), "123", "b@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user == nil {
+		t.Fatal("expected to find the user")
+	}
+
+	expected := []fakeplay.Request{{Method: http.MethodGet, Name: "developers/123/users"}}
+	if requests := play.Requests(); !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %+v, got %+v", expected, requests)
+	}
+}
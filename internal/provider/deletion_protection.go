@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deletionProtectionAttribute is the schema of the deletion_protection
+// attribute shared by resources that manage access.
+func deletionProtectionAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Whether Terraform is prevented from destroying or replacing the resource. Must be set to false and applied before the resource can be destroyed or replaced. Defaults to true.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(true),
+	}
+}
+
+// checkDeletionProtection returns an error if the resource described by name
+// is protected. deletionProtection must come from state, so the protection
+// has to be lifted by an apply before the resource can be removed.
+func checkDeletionProtection(deletionProtection types.Bool, action string, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if deletionProtection.ValueBool() {
+		diags.AddError(
+			"Deletion protection is enabled",
+			fmt.Sprintf("Cannot %s %s while deletion_protection is true. Set deletion_protection = false and apply before the resource can be destroyed or replaced.", action, name),
+		)
+	}
+	return diags
+}
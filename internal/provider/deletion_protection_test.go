@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/apimodel"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/lib"
+)
+
+// deletionProtectionTest is a plan of a resource from state, with a nil plan
+// standing for destroy.
+type deletionProtectionTest struct {
+	name        string
+	state       interface{}
+	plan        interface{}
+	expectError bool
+}
+
+// testDeletionProtection runs ModifyPlan for every test, then Delete for the
+// destroy tests. The resource has no provider context, so Delete must be
+// refused before any API call.
+func testDeletionProtection(t *testing.T, r resource.ResourceWithModifyPlan, tests []deletionProtectionTest) {
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := testResourceState(t, r, tt.state)
+			plan := testResourcePlan(t, r, tt.plan)
+
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, &resp)
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Errorf("expected plan error %t, got %v", tt.expectError, resp.Diagnostics)
+			}
+
+			if tt.plan == nil && tt.expectError {
+				deleteResp := resource.DeleteResponse{State: state}
+				r.Delete(ctx, resource.DeleteRequest{State: state}, &deleteResp)
+				if !deleteResp.Diagnostics.HasError() {
+					t.Error("expected delete to be refused")
+				}
+			}
+		})
+	}
+}
+
+func TestGrantResourceDeletionProtection(t *testing.T) {
+	model := func(packageName string, deletionProtection bool) *GrantResourceModel {
+		return &GrantResourceModel{
+			GrantModel: apimodel.GrantModel{
+				AppLevelPermissions: lib.StrListToTfModel([]string{"CAN_REPLY_TO_REVIEWS"}),
+				Name:                types.StringValue("developers/123/users/user@example.com/grants/" + packageName),
+				PackageName:         types.StringValue(packageName),
+			},
+			DeveloperID:        types.StringValue("123"),
+			Email:              types.StringValue("user@example.com"),
+			DeletionProtection: types.BoolValue(deletionProtection),
+		}
+	}
+
+	renamed := model("com.example.other", false)
+	testDeletionProtection(t, &GrantResource{}, []deletionProtectionTest{
+		{"destroy protected", model("com.example.app", true), nil, true},
+		{"destroy unprotected", model("com.example.app", false), nil, false},
+		{"replace protected", model("com.example.app", true), renamed, true},
+		{"replace unprotected", model("com.example.app", false), renamed, false},
+	})
+}
+
+func TestAppAccessPolicyResourceDeletionProtection(t *testing.T) {
+	model := func(packageName string, deletionProtection bool) *AppAccessPolicyResourceModel {
+		return &AppAccessPolicyResourceModel{
+			DeveloperID: types.StringValue("123"),
+			PackageName: types.StringValue(packageName),
+			AppLevelPermissions: types.MapValueMust(types.SetType{ElemType: types.StringType}, map[string]attr.Value{
+				"user@example.com": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("CAN_REPLY_TO_REVIEWS")}),
+			}),
+			Grants:             types.ListNull(grantType),
+			DeletionProtection: types.BoolValue(deletionProtection),
+		}
+	}
+
+	renamed := model("com.example.other", false)
+	testDeletionProtection(t, &AppAccessPolicyResource{}, []deletionProtectionTest{
+		{"destroy protected", model("com.example.app", true), nil, true},
+		{"destroy unprotected", model("com.example.app", false), nil, false},
+		{"replace protected", model("com.example.app", true), renamed, true},
+		{"replace unprotected", model("com.example.app", false), renamed, false},
+		{"update protected", model("com.example.app", true), model("com.example.app", true), false},
+	})
+}
+
+func TestDeveloperAccountUsersResourceDeletionProtection(t *testing.T) {
+	model := func(developerID string, deletionProtection bool) *DeveloperAccountUsersResourceModel {
+		return &DeveloperAccountUsersResourceModel{
+			DeveloperID:        types.StringValue(developerID),
+			Users:              types.MapValueMust(types.ObjectType{AttrTypes: AccountUserAttrTypes()}, map[string]attr.Value{}),
+			ExemptEmails:       types.SetNull(types.StringType),
+			ExemptAccountOwner: types.BoolValue(true),
+			ExemptCaller:       types.BoolValue(true),
+			DeletionProtection: types.BoolValue(deletionProtection),
+		}
+	}
+
+	moved := model("456", false)
+	testDeletionProtection(t, &DeveloperAccountUsersResource{}, []deletionProtectionTest{
+		{"destroy protected", model("123", true), nil, true},
+		{"destroy unprotected", model("123", false), nil, false},
+		{"replace protected", model("123", true), moved, true},
+		{"replace unprotected", model("123", false), moved, false},
+		{"update protected", model("123", true), model("123", true), false},
+	})
+}
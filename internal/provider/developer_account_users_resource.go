@@ -0,0 +1,728 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/mask"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/names"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/timetypes"
+
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DeveloperAccountUsersResource{}
+var _ resource.ResourceWithModifyPlan = &DeveloperAccountUsersResource{}
+var _ resource.ResourceWithImportState = &DeveloperAccountUsersResource{}
+
+// DeveloperAccountUsersResource manages the complete set of users of a developer account.
+type DeveloperAccountUsersResource struct {
+	*GoogleProviderContext
+}
+
+// DeveloperAccountUsersResourceModel describes the resource data model.
+type DeveloperAccountUsersResourceModel struct {
+	DeveloperID        types.String `tfsdk:"developer_id"`
+	Users              types.Map    `tfsdk:"users"`
+	ExemptEmails       types.Set    `tfsdk:"exempt_emails"`
+	ExemptAccountOwner types.Bool   `tfsdk:"exempt_account_owner"`
+	ExemptCaller       types.Bool   `tfsdk:"exempt_caller"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+}
+
+func (m *DeveloperAccountUsersResourceModel) description() string {
+	return fmt.Sprintf("the users of developer account %q", m.DeveloperID.ValueString())
+}
+
+// AccountUserModel describes a single entry of the users map.
+type AccountUserModel struct {
+	DeveloperAccountPermissions types.Set         `tfsdk:"developer_account_permissions"`
+	ExpirationTime              timetypes.RFC3339 `tfsdk:"expiration_time"`
+	Grants                      types.Map         `tfsdk:"grants"`
+}
+
+func AccountUserAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"developer_account_permissions": types.SetType{ElemType: types.StringType},
+		"expiration_time":               timetypes.RFC3339Type{},
+		"grants":                        types.MapType{ElemType: types.SetType{ElemType: types.StringType}},
+	}
+}
+
+// AccountUser is the access a user should hold in the developer account.
+type AccountUser struct {
+	DeveloperAccountPermissions []string
+	ExpirationTime              string
+	// Grants maps package names to app-level permissions.
+	Grants map[string][]string
+}
+
+func AccountUserFromUser(user *androidpublisher.User) AccountUser {
+	grants := make(map[string][]string)
+	for _, g := range user.Grants {
+		grants[grantKey(g)] = g.AppLevelPermissions
+	}
+	return AccountUser{
+		DeveloperAccountPermissions: user.DeveloperAccountPermissions,
+		ExpirationTime:              user.ExpirationTime,
+		Grants:                      grants,
+	}
+}
+
+// grantKey identifies a grant within a user. Draft apps have no package
+// name, so the app ID at the end of the grant name is used instead.
+func grantKey(g *androidpublisher.Grant) string {
+	if g.PackageName != "" {
+		return g.PackageName
+	}
+	return g.Name[strings.LastIndex(g.Name, "/")+1:]
+}
+
+// ToModel converts the user to an entry of the users map. Missing
+// permissions and grants become empty collections, matching the defaults.
+func (u AccountUser) ToModel(ctx context.Context) (AccountUserModel, diag.Diagnostics) {
+	permissions := u.DeveloperAccountPermissions
+	if permissions == nil {
+		permissions = []string{}
+	}
+	grants := u.Grants
+	if grants == nil {
+		grants = map[string][]string{}
+	}
+
+	permissionsValue, diags := types.SetValueFrom(ctx, types.StringType, permissions)
+	grantsValue, d := types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, grants)
+	diags.Append(d...)
+
+	expirationTime := timetypes.NewRFC3339Null()
+	if u.ExpirationTime != "" {
+		expirationTime = timetypes.NewRFC3339Value(u.ExpirationTime)
+	}
+
+	return AccountUserModel{
+		DeveloperAccountPermissions: permissionsValue,
+		ExpirationTime:              expirationTime,
+		Grants:                      grantsValue,
+	}, diags
+}
+
+// accountUserUpdateFields maps the attributes of a users entry that can be
+// patched to their API fields.
+var accountUserUpdateFields = mask.Fields{
+	"developer_account_permissions": "developerAccountPermissions",
+	"expiration_time":               "expirationTime",
+}
+
+// UpdateMask returns the user fields that differ between the user's current
+// and declared state.
+func (c AccountUserChange) UpdateMask(ctx context.Context) (mask.Mask, diag.Diagnostics) {
+	current, diags := AccountUserFromUser(c.Current).ToModel(ctx)
+	desired, d := c.Desired.ToModel(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	updateMask, d := mask.Diff(ctx, &desired, &current, accountUserUpdateFields)
+	diags.Append(d...)
+	return updateMask, diags
+}
+
+func sameElements(a []string, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(slices.Compact(a), slices.Compact(b))
+}
+
+func (u AccountUser) userFieldsEqual(other AccountUser) bool {
+	return sameElements(u.DeveloperAccountPermissions, other.DeveloperAccountPermissions) && sameInstant(u.ExpirationTime, other.ExpirationTime)
+}
+
+// sameInstant compares two optional RFC3339 timestamps.
+func sameInstant(a string, b string) bool {
+	if a == "" || b == "" {
+		return a == b
+	}
+	equal, _ := timetypes.NewRFC3339Value(a).StringSemanticEquals(context.Background(), timetypes.NewRFC3339Value(b))
+	return equal
+}
+
+func (u AccountUser) grantsEqual(other AccountUser) bool {
+	if len(u.Grants) != len(other.Grants) {
+		return false
+	}
+	for packageName, permissions := range u.Grants {
+		otherPermissions, ok := other.Grants[packageName]
+		if !ok || !sameElements(permissions, otherPermissions) {
+			return false
+		}
+	}
+	return true
+}
+
+// AccountUserChange pairs a user's current state in the developer account with the declared state.
+type AccountUserChange struct {
+	Email   string
+	Current *androidpublisher.User
+	Desired AccountUser
+}
+
+// PermissionChanges returns the developer account and per-app permission
+// changes needed to reach the declared state.
+func (c AccountUserChange) PermissionChanges() []PermissionChange {
+	var current AccountUser
+	if c.Current != nil {
+		current = AccountUserFromUser(c.Current)
+	}
+
+	changes := []PermissionChange{
+		DiffPermissions(c.Email, developerAccountScope, current.DeveloperAccountPermissions, c.Desired.DeveloperAccountPermissions),
+	}
+	packageNames := make([]string, 0, len(c.Desired.Grants))
+	for packageName := range c.Desired.Grants {
+		packageNames = append(packageNames, packageName)
+	}
+	sort.Strings(packageNames)
+	for _, packageName := range packageNames {
+		changes = append(changes, DiffPermissions(c.Email, packageName, current.Grants[packageName], c.Desired.Grants[packageName]))
+	}
+	return changes
+}
+
+// AccountUserChanges lists the calls needed to make the developer account match the declared users.
+type AccountUserChanges struct {
+	Create []AccountUserChange
+	Update []AccountUserChange
+	Delete []*androidpublisher.User
+}
+
+// DiffAccountUsers compares the users in the developer account with the
+// declared users. Exempt users are never updated or deleted.
+func DiffAccountUsers(current []*androidpublisher.User, desired map[string]AccountUser, isExempt func(*androidpublisher.User) bool) AccountUserChanges {
+	var changes AccountUserChanges
+	existing := make(map[string]*androidpublisher.User)
+	for _, user := range current {
+		existing[user.Email] = user
+		if _, ok := desired[user.Email]; !ok && !isExempt(user) {
+			changes.Delete = append(changes.Delete, user)
+		}
+	}
+
+	emails := make([]string, 0, len(desired))
+	for email := range desired {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+
+	for _, email := range emails {
+		want := desired[email]
+		user, ok := existing[email]
+		switch {
+		case !ok:
+			changes.Create = append(changes.Create, AccountUserChange{Email: email, Desired: want})
+		case isExempt(user):
+			continue
+		default:
+			have := AccountUserFromUser(user)
+			if !have.userFieldsEqual(want) || !have.grantsEqual(want) {
+				changes.Update = append(changes.Update, AccountUserChange{Email: email, Current: user, Desired: want})
+			}
+		}
+	}
+
+	sort.Slice(changes.Delete, func(i, j int) bool { return changes.Delete[i].Email < changes.Delete[j].Email })
+	return changes
+}
+
+// AccountUserExemptions decides which users the resource must leave untouched.
+type AccountUserExemptions struct {
+	Emails       []string
+	AccountOwner bool
+}
+
+func (e AccountUserExemptions) IsExempt(user *androidpublisher.User) bool {
+	return slices.Contains(e.Emails, user.Email) || (e.AccountOwner && user.Partial)
+}
+
+func (m *DeveloperAccountUsersResourceModel) GetExemptions(ctx context.Context, callerEmail string) (AccountUserExemptions, diag.Diagnostics) {
+	var emails []string
+	var diags diag.Diagnostics
+	if !m.ExemptEmails.IsNull() && !m.ExemptEmails.IsUnknown() {
+		diags = m.ExemptEmails.ElementsAs(ctx, &emails, false)
+	}
+	if m.ExemptCaller.ValueBool() && callerEmail != "" {
+		emails = append(emails, callerEmail)
+	}
+	return AccountUserExemptions{
+		Emails:       emails,
+		AccountOwner: m.ExemptAccountOwner.ValueBool(),
+	}, diags
+}
+
+func (m *DeveloperAccountUsersResourceModel) GetUsers(ctx context.Context) (map[string]AccountUser, diag.Diagnostics) {
+	var models map[string]AccountUserModel
+	diags := m.Users.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	users := make(map[string]AccountUser, len(models))
+	for email, model := range models {
+		var user AccountUser
+		diags.Append(model.DeveloperAccountPermissions.ElementsAs(ctx, &user.DeveloperAccountPermissions, false)...)
+		diags.Append(model.Grants.ElementsAs(ctx, &user.Grants, false)...)
+		user.ExpirationTime = model.ExpirationTime.ValueString()
+		users[email] = user
+	}
+	return users, diags
+}
+
+func (m *DeveloperAccountUsersResourceModel) SetUsers(ctx context.Context, users []*androidpublisher.User, exemptions AccountUserExemptions) diag.Diagnostics {
+	var diags diag.Diagnostics
+	models := make(map[string]AccountUserModel)
+	for _, user := range users {
+		if exemptions.IsExempt(user) {
+			continue
+		}
+		model, d := AccountUserFromUser(user).ToModel(ctx)
+		diags.Append(d...)
+		models[user.Email] = model
+	}
+
+	value, d := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: AccountUserAttrTypes()}, models)
+	diags.Append(d...)
+	m.Users = value
+	return diags
+}
+
+func NewDeveloperAccountUsersResource() resource.Resource {
+	return &DeveloperAccountUsersResource{}
+}
+
+func (r *DeveloperAccountUsersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_developer_account_users"
+}
+
+func (r *DeveloperAccountUsersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manages every user of a developer account. Users that exist in the developer account but are not declared in `users` are removed on apply, unless they are exempt. " +
+			"Destroying this resource removes every declared user from the developer account.",
+
+		Attributes: map[string]schema.Attribute{
+			"developer_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the developer account",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.MapNestedAttribute{
+				MarkdownDescription: "The complete set of users of the developer account, keyed by email address",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"developer_account_permissions": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							Computed:            true,
+							Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+							MarkdownDescription: "The permissions granted to the user across the developer account",
+						},
+						"expiration_time": schema.StringAttribute{
+							MarkdownDescription: "The time at which the user's access expires, as an RFC3339 timestamp",
+							CustomType:          timetypes.RFC3339Type{},
+							Optional:            true,
+						},
+						"grants": schema.MapAttribute{
+							ElementType:         types.SetType{ElemType: types.StringType},
+							Optional:            true,
+							Computed:            true,
+							Default:             mapdefault.StaticValue(types.MapValueMust(types.SetType{ElemType: types.StringType}, map[string]attr.Value{})),
+							MarkdownDescription: "The app-level permissions granted to the user, keyed by package name",
+						},
+					},
+				},
+			},
+			"exempt_emails": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Emails of users that are never created, modified or removed by this resource",
+			},
+			"exempt_account_owner": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether to leave users that cannot be fully managed through the API, such as the account owner, untouched. Defaults to true.",
+			},
+			"exempt_caller": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether to leave the identity the provider authenticates as untouched. Defaults to true.",
+			},
+			"deletion_protection": deletionProtectionAttribute(),
+		},
+	}
+}
+
+func (r *DeveloperAccountUsersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	gCtx, ok := req.ProviderData.(*GoogleProviderContext)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GoogleProviderContext, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.GoogleProviderContext = gCtx
+}
+
+func (r *DeveloperAccountUsersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var state DeveloperAccountUsersResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if req.Plan.Raw.IsNull() {
+		// Destroying the resource removes every declared user.
+		resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "destroy", state.description())...)
+		return
+	}
+
+	var data DeveloperAccountUsersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !req.State.Raw.IsNull() && !data.DeveloperID.Equal(state.DeveloperID) {
+		resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "replace", state.description())...)
+	}
+	if resp.Diagnostics.HasError() || !req.Plan.Raw.IsFullyKnown() || r.GoogleProviderContext == nil {
+		return
+	}
+
+	desired, diags := data.GetUsers(ctx)
+	resp.Diagnostics.Append(diags...)
+	exemptions, diags := data.GetExemptions(ctx, r.CallerEmail)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for email := range desired {
+		if slices.Contains(exemptions.Emails, email) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("users").AtMapKey(email),
+				"Exempt user declared",
+				fmt.Sprintf("User %q is exempt from management and cannot be declared in users. Remove it from users, or from exempt_emails and set exempt_caller = false if it is the provider's own identity.", email),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.ListUsers(ctx, data.DeveloperID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list users", err.Error())
+		return
+	}
+
+	changes := DiffAccountUsers(current, desired, exemptions.IsExempt)
+	var permissionChanges []PermissionChange
+	for _, change := range append(changes.Create, changes.Update...) {
+		permissionChanges = append(permissionChanges, change.PermissionChanges()...)
+	}
+	resp.Diagnostics.Append(r.CheckEscalations(permissionChanges)...)
+
+	if len(changes.Delete) > 0 {
+		emails := make([]string, 0, len(changes.Delete))
+		for _, user := range changes.Delete {
+			emails = append(emails, user.Email)
+		}
+		resp.Diagnostics.AddWarning(
+			"Unmanaged users will be removed",
+			fmt.Sprintf("The following users of developer account %q are not declared in users and will be removed on apply:\n  - %s", data.DeveloperID.ValueString(), strings.Join(emails, "\n  - ")),
+		)
+	}
+}
+
+func (r *DeveloperAccountUsersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeveloperAccountUsersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created a developer account users resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeveloperAccountUsersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeveloperAccountUsersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := r.ListUsers(ctx, data.DeveloperID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading users", fmt.Sprintf("Unable to read users: %v", err))
+		return
+	}
+
+	exemptions, diags := data.GetExemptions(ctx, r.CallerEmail)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(data.SetUsers(ctx, users, exemptions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeveloperAccountUsersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DeveloperAccountUsersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a developer account users resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeveloperAccountUsersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DeveloperAccountUsersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkDeletionProtection(data.DeletionProtection, "destroy", data.description())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	declared, diags := data.GetUsers(ctx)
+	resp.Diagnostics.Append(diags...)
+	exemptions, diags := data.GetExemptions(ctx, r.CallerEmail)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	developerID := data.DeveloperID.ValueString()
+	r.InvalidateUsers(developerID)
+	current, err := r.ListUsers(ctx, developerID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting users", fmt.Sprintf("Unable to list users: %v", err))
+		return
+	}
+
+	for _, user := range current {
+		if _, ok := declared[user.Email]; !ok || exemptions.IsExempt(user) {
+			continue
+		}
+		err := r.Mutate(ctx, developerID, func() error {
+			return r.Users.Delete(ctx, user.Name)
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error deleting user", fmt.Sprintf("Unable to delete user %q: %v", user.Email, err))
+			return
+		}
+	}
+}
+
+func (r *DeveloperAccountUsersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if err := names.ValidateDeveloperID(req.ID); err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("developer_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("exempt_account_owner"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("exempt_caller"), true)...)
+}
+
+// apply creates, patches and deletes users until the developer account matches the declared users.
+func (r *DeveloperAccountUsersResource) apply(ctx context.Context, data DeveloperAccountUsersResourceModel) diag.Diagnostics {
+	desired, diags := data.GetUsers(ctx)
+	exemptions, d := data.GetExemptions(ctx, r.CallerEmail)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	developerID := data.DeveloperID.ValueString()
+	r.InvalidateUsers(developerID)
+	current, err := r.ListUsers(ctx, developerID)
+	if err != nil {
+		diags.AddError("Failed to list users", err.Error())
+		return diags
+	}
+
+	changes := DiffAccountUsers(current, desired, exemptions.IsExempt)
+
+	for _, change := range changes.Create {
+		user := &androidpublisher.User{
+			Email:                       change.Email,
+			Name:                        names.User{DeveloperID: developerID, Email: change.Email}.String(),
+			DeveloperAccountPermissions: change.Desired.DeveloperAccountPermissions,
+			ExpirationTime:              change.Desired.ExpirationTime,
+		}
+		var created *androidpublisher.User
+		err := r.Mutate(ctx, developerID, func() (err error) {
+			created, err = r.Users.Create(ctx, names.Developer{ID: developerID}.String(), user)
+			return err
+		})
+		if err != nil {
+			diags.AddError("Error creating user", fmt.Sprintf("Unable to create user %q: %v", change.Email, err))
+			return diags
+		}
+		tflog.Debug(ctx, "created user", map[string]interface{}{"email": change.Email})
+		if err := r.syncGrants(ctx, developerID, created, change.Desired.Grants); err != nil {
+			diags.AddError("Error updating grants", fmt.Sprintf("Unable to update grants of user %q: %v", change.Email, err))
+			return diags
+		}
+	}
+
+	for _, change := range changes.Update {
+		updateMask, d := change.UpdateMask(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		if len(updateMask) > 0 {
+			user := &androidpublisher.User{
+				DeveloperAccountPermissions: change.Desired.DeveloperAccountPermissions,
+				ExpirationTime:              change.Desired.ExpirationTime,
+			}
+			if updateMask.Contains("expirationTime") && change.Desired.ExpirationTime == "" {
+				// Send an explicit null so the API removes the expiration.
+				user.NullFields = append(user.NullFields, "ExpirationTime")
+			}
+			err := r.Mutate(ctx, developerID, func() error {
+				_, err := r.Users.Patch(ctx, change.Current.Name, user, updateMask.String())
+				return err
+			})
+			if err != nil {
+				diags.AddError("Error updating user", fmt.Sprintf("Unable to update user %q: %v", change.Email, err))
+				return diags
+			}
+			tflog.Debug(ctx, "updated user", map[string]interface{}{"email": change.Email})
+		}
+		if err := r.syncGrants(ctx, developerID, change.Current, change.Desired.Grants); err != nil {
+			diags.AddError("Error updating grants", fmt.Sprintf("Unable to update grants of user %q: %v", change.Email, err))
+			return diags
+		}
+	}
+
+	for _, user := range changes.Delete {
+		err := r.Mutate(ctx, developerID, func() error {
+			return r.Users.Delete(ctx, user.Name)
+		})
+		if err != nil {
+			diags.AddError("Error deleting user", fmt.Sprintf("Unable to delete user %q: %v", user.Email, err))
+			return diags
+		}
+		tflog.Debug(ctx, "deleted unmanaged user", map[string]interface{}{"email": user.Email})
+	}
+
+	return diags
+}
+
+// syncGrants creates, patches and deletes the user's grants until they match the declared grants.
+func (r *DeveloperAccountUsersResource) syncGrants(ctx context.Context, developerID string, user *androidpublisher.User, desired map[string][]string) error {
+	existing := make(map[string]*androidpublisher.Grant)
+	for _, g := range user.Grants {
+		existing[grantKey(g)] = g
+	}
+
+	packageNames := make([]string, 0, len(desired))
+	for packageName := range desired {
+		packageNames = append(packageNames, packageName)
+	}
+	sort.Strings(packageNames)
+
+	for _, packageName := range packageNames {
+		permissions := desired[packageName]
+		current, ok := existing[packageName]
+		switch {
+		case !ok:
+			g := &androidpublisher.Grant{
+				Name:                names.Grant{DeveloperID: developerID, Email: user.Email, PackageName: packageName}.String(),
+				PackageName:         packageName,
+				AppLevelPermissions: permissions,
+			}
+			err := r.Mutate(ctx, developerID, func() error {
+				_, err := r.Grants.Create(ctx, user.Name, g)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+		case !sameElements(current.AppLevelPermissions, permissions):
+			g := &androidpublisher.Grant{AppLevelPermissions: permissions}
+			err := r.Mutate(ctx, developerID, func() error {
+				_, err := r.Grants.Patch(ctx, current.Name, g, "appLevelPermissions")
+				return err
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for packageName, current := range existing {
+		if _, ok := desired[packageName]; ok {
+			continue
+		}
+		err := r.Mutate(ctx, developerID, func() error {
+			return r.Grants.Delete(ctx, current.Name)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
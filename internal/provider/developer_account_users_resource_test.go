@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/fakeplay"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/timetypes"
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+func TestDiffAccountUsers(t *testing.T) {
+	current := []*androidpublisher.User{
+		{Email: "owner@example.com", Partial: true},
+		{Email: "unchanged@example.com", DeveloperAccountPermissions: []string{"B", "A"}, Grants: []*androidpublisher.Grant{
+			{Name: "developers/1/users/unchanged@example.com/grants/com.example.app", PackageName: "com.example.app", AppLevelPermissions: []string{"CAN_REPLY_TO_REVIEWS"}},
+		}},
+		{Email: "changed@example.com", DeveloperAccountPermissions: []string{"A"}},
+		{Email: "unmanaged@example.com"},
+		{Email: "exempt@example.com"},
+	}
+	desired := map[string]AccountUser{
+		"unchanged@example.com": {DeveloperAccountPermissions: []string{"A", "B"}, Grants: map[string][]string{"com.example.app": {"CAN_REPLY_TO_REVIEWS"}}},
+		"changed@example.com":   {DeveloperAccountPermissions: []string{"A"}, Grants: map[string][]string{"com.example.app": {"CAN_REPLY_TO_REVIEWS"}}},
+		"new@example.com":       {DeveloperAccountPermissions: []string{"A"}},
+	}
+	exemptions := AccountUserExemptions{Emails: []string{"exempt@example.com"}, AccountOwner: true}
+
+	changes := DiffAccountUsers(current, desired, exemptions.IsExempt)
+
+	if len(changes.Create) != 1 || changes.Create[0].Email != "new@example.com" {
+		t.Errorf("expected only new@example.com to be created, got %+v", changes.Create)
+	}
+	if len(changes.Update) != 1 || changes.Update[0].Email != "changed@example.com" {
+		t.Errorf("expected only changed@example.com to be updated, got %+v", changes.Update)
+	}
+	if len(changes.Delete) != 1 || changes.Delete[0].Email != "unmanaged@example.com" {
+		t.Errorf("expected only unmanaged@example.com to be deleted, got %+v", changes.Delete)
+	}
+
+	exemptions.AccountOwner = false
+	changes = DiffAccountUsers(current, desired, exemptions.IsExempt)
+	if len(changes.Delete) != 2 || changes.Delete[0].Email != "owner@example.com" {
+		t.Errorf("expected the account owner to be deleted once the exemption is disabled, got %+v", changes.Delete)
+	}
+}
+
+func TestDeveloperAccountUsersResourceUpdateMask(t *testing.T) {
+	ctx := context.Background()
+	const userName = "developers/123/users/user@example.com"
+	current := &androidpublisher.User{
+		Email:                       "user@example.com",
+		DeveloperAccountPermissions: []string{"CAN_VIEW_APP_QUALITY_GLOBAL"},
+		ExpirationTime:              "2030-01-01T00:00:00Z",
+	}
+
+	model := func(expirationTime timetypes.RFC3339, permissions ...string) DeveloperAccountUsersResourceModel {
+		values := make([]attr.Value, 0, len(permissions))
+		for _, permission := range permissions {
+			values = append(values, types.StringValue(permission))
+		}
+		user := types.ObjectValueMust(AccountUserAttrTypes(), map[string]attr.Value{
+			"developer_account_permissions": types.SetValueMust(types.StringType, values),
+			"expiration_time":               expirationTime,
+			"grants":                        types.MapValueMust(types.SetType{ElemType: types.StringType}, map[string]attr.Value{}),
+		})
+		return DeveloperAccountUsersResourceModel{
+			DeveloperID:        types.StringValue("123"),
+			Users:              types.MapValueMust(types.ObjectType{AttrTypes: AccountUserAttrTypes()}, map[string]attr.Value{"user@example.com": user}),
+			ExemptEmails:       types.SetNull(types.StringType),
+			ExemptAccountOwner: types.BoolValue(true),
+			ExemptCaller:       types.BoolValue(true),
+		}
+	}
+
+	tests := map[string]struct {
+		data     DeveloperAccountUsersResourceModel
+		expected []fakeplay.Request
+	}{
+		"permissions changed": {
+			data: model(timetypes.NewRFC3339Value("2030-01-01T00:00:00Z"), "CAN_VIEW_APP_QUALITY_GLOBAL", "CAN_REPLY_TO_REVIEWS_GLOBAL"),
+			expected: []fakeplay.Request{{
+				Method:     http.MethodPatch,
+				Name:       userName,
+				UpdateMask: "developerAccountPermissions",
+				Body: map[string]interface{}{
+					"developerAccountPermissions": []interface{}{"CAN_VIEW_APP_QUALITY_GLOBAL", "CAN_REPLY_TO_REVIEWS_GLOBAL"},
+					"expirationTime":              "2030-01-01T00:00:00Z",
+				},
+			}},
+		},
+		"expiration removed": {
+			data: model(timetypes.NewRFC3339Null(), "CAN_VIEW_APP_QUALITY_GLOBAL"),
+			expected: []fakeplay.Request{{
+				Method:     http.MethodPatch,
+				Name:       userName,
+				UpdateMask: "expirationTime",
+				Body: map[string]interface{}{
+					"developerAccountPermissions": []interface{}{"CAN_VIEW_APP_QUALITY_GLOBAL"},
+					"expirationTime":              nil,
+				},
+			}},
+		},
+		"expiration in another offset": {
+			data: model(timetypes.NewRFC3339Value("2030-01-01T01:00:00+01:00"), "CAN_VIEW_APP_QUALITY_GLOBAL"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			play, gCtx := newTestFakePlay(t)
+			play.PutUser("123", current)
+			r := &DeveloperAccountUsersResource{GoogleProviderContext: gCtx}
+			if diags := r.apply(ctx, tt.data); diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			if requests := testRequests(play, http.MethodPost, http.MethodPatch, http.MethodDelete); !reflect.DeepEqual(requests, tt.expected) {
+				t.Errorf("expected requests %+v, got %+v", tt.expected, requests)
+			}
+		})
+	}
+}
+
+func TestAccDeveloperAccountUsersResource(t *testing.T) {
+	config := func(permissions string) string {
+		return fmt.Sprintf(`
+data "androidpublisher_user" "existing" {
+  developer_id = %[2]q
+}
+
+resource "androidpublisher_developer_account_users" "test" {
+  developer_id = %[2]q
+  deletion_protection = false
+
+  # Only the test user is managed, every other existing user is exempt.
+  exempt_emails = [for u in data.androidpublisher_user.existing.value : u.email if u.email != %[1]q]
+
+  users = {
+    %[1]q = {
+      developer_account_permissions = %[3]s
+    }
+  }
+}
+`, env.TestEmail, env.TestDeveloperId, permissions)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`["CAN_VIEW_APP_QUALITY_GLOBAL"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("androidpublisher_developer_account_users.test", "users.%", "1"),
+					resource.TestCheckResourceAttr("androidpublisher_developer_account_users.test", fmt.Sprintf("users.%s.developer_account_permissions.#", env.TestEmail), "1"),
+				),
+			},
+			{
+				Config: config(`["CAN_VIEW_APP_QUALITY_GLOBAL", "CAN_VIEW_NON_FINANCIAL_DATA_GLOBAL"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("androidpublisher_developer_account_users.test", fmt.Sprintf("users.%s.developer_account_permissions.#", env.TestEmail), "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
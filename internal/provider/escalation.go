@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/permissions"
+)
+
+// developerAccountScope is the PermissionChange scope of developer account permissions.
+const developerAccountScope = "the developer account"
+
+// PermissionChange is the difference between the permissions a user holds
+// and the permissions planned for them within one scope.
+type PermissionChange struct {
+	Email string
+	// Scope is the developer account, or the package name of a grant.
+	Scope   string
+	Added   []string
+	Removed []string
+}
+
+// DiffPermissions compares the current and planned permissions of a user.
+func DiffPermissions(email string, scope string, current []string, planned []string) PermissionChange {
+	change := PermissionChange{Email: email, Scope: scope}
+	for _, permission := range planned {
+		if !slices.Contains(current, permission) {
+			change.Added = append(change.Added, permission)
+		}
+	}
+	for _, permission := range current {
+		if !slices.Contains(planned, permission) {
+			change.Removed = append(change.Removed, permission)
+		}
+	}
+	return change
+}
+
+// Escalations returns the sensitive permissions the change adds.
+func (c PermissionChange) Escalations() []string {
+	var escalations []string
+	for _, permission := range c.Added {
+		if slices.Contains(permissions.Sensitive, permission) {
+			escalations = append(escalations, permission)
+		}
+	}
+	return escalations
+}
+
+// EscalationDiagnostics warns about every sensitive permission the changes
+// add. Escalations to a permission listed in errorOn are errors instead.
+func EscalationDiagnostics(changes []PermissionChange, errorOn []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var warnings, errors []string
+	for _, change := range changes {
+		email := change.Email
+		if email == "" {
+			email = "(email known after apply)"
+		}
+		for _, permission := range change.Escalations() {
+			line := fmt.Sprintf("%s gains %s on %s", email, permission, change.Scope)
+			if slices.Contains(errorOn, permission) {
+				errors = append(errors, line)
+			} else {
+				warnings = append(warnings, line)
+			}
+		}
+	}
+
+	if len(warnings) > 0 {
+		diags.AddWarning(
+			"Privilege escalation",
+			fmt.Sprintf("This plan grants sensitive permissions:\n  - %s", strings.Join(warnings, "\n  - ")),
+		)
+	}
+	if len(errors) > 0 {
+		diags.AddError(
+			"Privilege escalation not allowed",
+			fmt.Sprintf("This plan grants permissions listed in the provider's escalation_errors:\n  - %s", strings.Join(errors, "\n  - ")),
+		)
+	}
+	return diags
+}
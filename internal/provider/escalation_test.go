@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+func TestDiffPermissions(t *testing.T) {
+	change := DiffPermissions("user@example.com", developerAccountScope,
+		[]string{"CAN_VIEW_APP_QUALITY_GLOBAL", "CAN_REPLY_TO_REVIEWS_GLOBAL"},
+		[]string{"CAN_VIEW_APP_QUALITY_GLOBAL", "CAN_MANAGE_PERMISSIONS_GLOBAL"},
+	)
+	if !slices.Equal(change.Added, []string{"CAN_MANAGE_PERMISSIONS_GLOBAL"}) {
+		t.Errorf("unexpected added permissions %v", change.Added)
+	}
+	if !slices.Equal(change.Removed, []string{"CAN_REPLY_TO_REVIEWS_GLOBAL"}) {
+		t.Errorf("unexpected removed permissions %v", change.Removed)
+	}
+	if !slices.Equal(change.Escalations(), []string{"CAN_MANAGE_PERMISSIONS_GLOBAL"}) {
+		t.Errorf("unexpected escalations %v", change.Escalations())
+	}
+}
+
+func TestEscalationDiagnostics(t *testing.T) {
+	changes := []PermissionChange{
+		DiffPermissions("a@example.com", developerAccountScope, nil, []string{"CAN_VIEW_FINANCIAL_DATA_GLOBAL"}),
+		DiffPermissions("b@example.com", "com.example.app", nil, []string{"CAN_MANAGE_PERMISSIONS", "CAN_REPLY_TO_REVIEWS"}),
+		DiffPermissions("c@example.com", developerAccountScope, []string{"CAN_MANAGE_PERMISSIONS_GLOBAL"}, nil),
+	}
+
+	diags := EscalationDiagnostics(changes, nil)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	detail := diags.Warnings()[0].Detail()
+	for _, expected := range []string{"a@example.com gains CAN_VIEW_FINANCIAL_DATA_GLOBAL", "b@example.com gains CAN_MANAGE_PERMISSIONS on com.example.app"} {
+		if !strings.Contains(detail, expected) {
+			t.Errorf("expected warning to contain %q, got %q", expected, detail)
+		}
+	}
+	if strings.Contains(detail, "c@example.com") || strings.Contains(detail, "CAN_REPLY_TO_REVIEWS") {
+		t.Errorf("expected only escalations to sensitive permissions, got %q", detail)
+	}
+
+	diags = EscalationDiagnostics(changes, []string{"CAN_MANAGE_PERMISSIONS"})
+	if diags.ErrorsCount() != 1 || diags.WarningsCount() != 1 {
+		t.Fatalf("expected one error and one warning, got %v", diags)
+	}
+	if !strings.Contains(diags.Errors()[0].Detail(), "b@example.com") {
+		t.Errorf("expected the error to name b@example.com, got %q", diags.Errors()[0].Detail())
+	}
+}
+
+func TestAccountUserChangePermissionChanges(t *testing.T) {
+	change := AccountUserChange{
+		Email: "a@example.com",
+		Current: &androidpublisher.User{
+			Email:  "a@example.com",
+			Grants: []*androidpublisher.Grant{{PackageName: "com.example.app", AppLevelPermissions: []string{"CAN_REPLY_TO_REVIEWS"}}},
+		},
+		Desired: AccountUser{
+			Grants: map[string][]string{"com.example.app": {"CAN_REPLY_TO_REVIEWS", "CAN_VIEW_FINANCIAL_DATA"}},
+		},
+	}
+
+	changes := change.PermissionChanges()
+	if len(changes) != 2 {
+		t.Fatalf("expected developer account and grant changes, got %v", changes)
+	}
+	if !slices.Equal(changes[1].Escalations(), []string{"CAN_VIEW_FINANCIAL_DATA"}) {
+		t.Errorf("unexpected grant escalations %v", changes[1].Escalations())
+	}
+}
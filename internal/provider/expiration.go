@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/timetypes"
+)
+
+// now is replaced in tests.
+var now = time.Now
+
+var _ planmodifier.String = expirationTimePlanModifier{}
+
+// expirationTimePlanModifier plans expiration_time from either its own
+// configuration or from expires_in, which is resolved against the current
+// time when it is first applied and kept stable afterwards.
+type expirationTimePlanModifier struct{}
+
+func (m expirationTimePlanModifier) Description(ctx context.Context) string {
+	return "Plans expiration_time from expires_in and warns when the expiration is in the past."
+}
+
+func (m expirationTimePlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m expirationTimePlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() {
+		if req.ConfigValue.IsUnknown() {
+			return
+		}
+		expiration, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString())
+		if err == nil && !expiration.After(now()) {
+			resp.Diagnostics.AddAttributeWarning(
+				req.Path,
+				"Expiration time is in the past",
+				fmt.Sprintf("expiration_time %s is not in the future. The API rejects expiration times in the past when they are sent.", req.ConfigValue.ValueString()),
+			)
+		}
+		return
+	}
+
+	var expiresIn, stateExpiresIn types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("expires_in"), &expiresIn)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if expiresIn.IsNull() {
+		resp.PlanValue = types.StringNull()
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("expires_in"), &stateExpiresIn)...)
+		if !req.StateValue.IsNull() && stateExpiresIn.Equal(expiresIn) {
+			resp.PlanValue = req.StateValue
+			return
+		}
+	}
+	resp.PlanValue = types.StringUnknown()
+}
+
+// ResolveExpirationTime returns the expiration to send to the API: the
+// configured expiration_time, or the current time plus expires_in.
+func ResolveExpirationTime(expirationTime timetypes.RFC3339, expiresIn types.String) (timetypes.RFC3339, error) {
+	if !expirationTime.IsUnknown() {
+		return expirationTime, nil
+	}
+	duration, err := time.ParseDuration(expiresIn.ValueString())
+	if err != nil {
+		return expirationTime, fmt.Errorf("invalid expires_in %q: %w", expiresIn.ValueString(), err)
+	}
+	return timetypes.NewRFC3339TimeValue(now().Add(duration).UTC()), nil
+}
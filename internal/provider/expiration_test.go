@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/timetypes"
+)
+
+func setNow(t *testing.T, value time.Time) {
+	previous := now
+	now = func() time.Time { return value }
+	t.Cleanup(func() { now = previous })
+}
+
+func TestResolveExpirationTime(t *testing.T) {
+	setNow(t, time.Date(2030, 1, 1, 12, 0, 0, 0, time.FixedZone("CET", 3600)))
+
+	configured := timetypes.NewRFC3339Value("2031-01-01T00:00:00Z")
+	got, err := ResolveExpirationTime(configured, types.StringNull())
+	if err != nil || !got.Equal(configured) {
+		t.Errorf("expected configured expiration to be kept, got %s, %v", got, err)
+	}
+
+	got, err = ResolveExpirationTime(timetypes.NewRFC3339Unknown(), types.StringValue("720h"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "2030-01-31T11:00:00Z"; got.ValueString() != want {
+		t.Errorf("expected expires_in to resolve to %s, got %s", want, got.ValueString())
+	}
+
+	if _, err := ResolveExpirationTime(timetypes.NewRFC3339Unknown(), types.StringValue("a month")); err == nil {
+		t.Error("expected an invalid duration to fail")
+	}
+}
+
+func TestExpirationTimePlanModifierWarnsAboutPastExpiration(t *testing.T) {
+	setNow(t, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	tests := map[string]struct {
+		config      string
+		wantWarning bool
+	}{
+		"future": {"2030-06-01T00:00:00Z", false},
+		"past":   {"2029-06-01T00:00:00Z", true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				Path:        path.Root("expiration_time"),
+				ConfigValue: types.StringValue(tt.config),
+				PlanValue:   types.StringValue(tt.config),
+			}
+			resp := planmodifier.StringResponse{PlanValue: req.PlanValue}
+			expirationTimePlanModifier{}.PlanModifyString(context.Background(), req, &resp)
+
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.wantWarning {
+				t.Errorf("expected warning %v, got diagnostics %v", tt.wantWarning, resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(req.ConfigValue) {
+				t.Errorf("expected configured value to be planned, got %s", resp.PlanValue)
+			}
+		})
+	}
+}
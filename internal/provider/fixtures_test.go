@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/lib"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/timetypes"
+)
+
+// testResourceState returns the state of r holding model. A nil model is the
+// null state of a resource that does not exist yet.
+func testResourceState(t *testing.T, r resource.Resource, model interface{}) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if model != nil {
+		if diags := state.Set(ctx, model); diags.HasError() {
+			t.Fatal(diags)
+		}
+	}
+	return state
+}
+
+// testResourcePlan returns the plan of r holding model. A nil model is the
+// null plan of a destroy.
+func testResourcePlan(t *testing.T, r resource.Resource, model interface{}) tfsdk.Plan {
+	t.Helper()
+	state := testResourceState(t, r, model)
+	return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+}
+
+// testResourceConfig returns the configuration of r holding model.
+func testResourceConfig(t *testing.T, r resource.Resource, model interface{}) tfsdk.Config {
+	t.Helper()
+	state := testResourceState(t, r, model)
+	return tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+}
+
+// testDataSourceRead returns the configuration of d with only developer_id
+// set, and the null state its Read fills in.
+func testDataSourceRead(t *testing.T, d datasource.DataSource, developerID string) (tfsdk.Config, tfsdk.State) {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	configType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	configValues := map[string]tftypes.Value{}
+	for name, attrType := range configType.AttributeTypes {
+		configValues[name] = tftypes.NewValue(attrType, nil)
+	}
+	configValues["developer_id"] = tftypes.NewValue(tftypes.String, developerID)
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(configType, configValues)}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(configType, nil)}
+	return config, state
+}
+
+// testDataSourceConfig returns the configuration of d holding model, and the
+// null state its Read fills in.
+func testDataSourceConfig(t *testing.T, d datasource.DataSource, model interface{}) (tfsdk.Config, tfsdk.State) {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	config := tfsdk.State{Schema: schemaResp.Schema, Raw: state.Raw}
+	if diags := config.Set(ctx, model); diags.HasError() {
+		t.Fatal(diags)
+	}
+	return tfsdk.Config{Schema: config.Schema, Raw: config.Raw}, state
+}
+
+// testUserModel returns the model of a granted user named testUserName with
+// every provider-only attribute at its default, changed by mutate.
+func testUserModel(mutate func(*UserResourceModel)) *UserResourceModel {
+	model := &UserResourceModel{
+		AccessState:                 types.StringValue(AccessStateGranted),
+		DeveloperID:                 types.StringValue("123"),
+		Email:                       types.StringValue("invitee@example.com"),
+		ExpirationTime:              timetypes.NewRFC3339Null(),
+		ExpiresIn:                   types.StringNull(),
+		Grants:                      types.ListNull(grantType),
+		Name:                        types.StringValue(testUserName),
+		DeveloperAccountPermissions: lib.StrListToTfModel([]string{"CAN_VIEW_APP_QUALITY_GLOBAL"}),
+		ReinviteOnExpiry:            types.BoolValue(false),
+		WaitForAcceptance:           types.BoolValue(false),
+		WaitForAcceptanceTimeout:    types.StringValue("30m"),
+		AllowAdminRemoval:           types.BoolValue(false),
+		DeletionProtection:          types.BoolValue(false),
+		PrincipalType:               types.StringValue(PrincipalTypeUser),
+	}
+	if mutate != nil {
+		mutate(model)
+	}
+	return model
+}
+
+// testUserState returns the state of r holding testUserModel(mutate).
+func testUserState(t *testing.T, r *UserResource, mutate func(*UserResourceModel)) tfsdk.State {
+	t.Helper()
+	return testResourceState(t, r, testUserModel(mutate))
+}
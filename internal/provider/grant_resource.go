@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/apimodel"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/lib"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/mask"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/names"
+
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GrantResource{}
+var _ resource.ResourceWithImportState = &GrantResource{}
+var _ resource.ResourceWithModifyPlan = &GrantResource{}
+var _ resource.ResourceWithValidateConfig = &GrantResource{}
+
+// GrantResource defines the resource implementation.
+type GrantResource struct {
+	*GoogleProviderContext
+}
+
+// GrantResourceModel describes the resource data model.
+type GrantResourceModel struct {
+	// GrantModel holds the attributes of the API grant.
+	apimodel.GrantModel
+	DeveloperID        types.String `tfsdk:"developer_id"`
+	Email              types.String `tfsdk:"email"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+}
+
+func (m *GrantResourceModel) GetParent() string {
+	return m.GrantName().User().String()
+}
+
+func (m *GrantResourceModel) GetName() string {
+	return m.GrantName().String()
+}
+
+// GrantName returns the components of the grant's resource name.
+func (m *GrantResourceModel) GrantName() names.Grant {
+	return names.Grant{DeveloperID: m.DeveloperID.ValueString(), Email: m.Email.ValueString(), PackageName: m.PackageName.ValueString()}
+}
+
+func NewGrantResource() resource.Resource {
+	return &GrantResource{}
+}
+
+func (r *GrantResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grant"
+}
+
+func (r *GrantResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := apimodel.GrantAttributes()
+	attributes["developer_id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the developer account",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["email"] = schema.StringAttribute{
+		MarkdownDescription: "The email address of the user receiving the grant. The user must already exist in the developer account.",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["deletion_protection"] = deletionProtectionAttribute()
+
+	packageName := attributes["package_name"].(schema.StringAttribute)
+	packageName.PlanModifiers = []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+	attributes["package_name"] = packageName
+
+	name := attributes["name"].(schema.StringAttribute)
+	name.PlanModifiers = []planmodifier.String{
+		stringplanmodifier.UseStateForUnknown(),
+	}
+	attributes["name"] = name
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a user's access to a single app. Maps to the https://developers.google.com/android-publisher/api-ref/rest/v3/grants endpoints.",
+
+		Attributes: attributes,
+	}
+}
+
+func (r *GrantResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	gCtx, ok := req.ProviderData.(*GoogleProviderContext)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GoogleProviderContext, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.GoogleProviderContext = gCtx
+}
+
+func (r *GrantResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GrantResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checks := []struct {
+		attribute string
+		value     types.String
+		validate  func(string) error
+		summary   string
+	}{
+		{"developer_id", data.DeveloperID, names.ValidateDeveloperID, "Invalid developer ID"},
+		{"email", data.Email, names.ValidateEmail, "Invalid email"},
+		{"package_name", data.PackageName, names.ValidatePackageName, "Invalid package name"},
+	}
+	for _, check := range checks {
+		if check.value.IsUnknown() || check.value.IsNull() {
+			continue
+		}
+		if err := check.validate(check.value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(check.attribute), check.summary, err.Error())
+		}
+	}
+}
+
+func (r *GrantResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		var state GrantResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "destroy", fmt.Sprintf("grant %q", state.GetName()))...)
+		return
+	}
+
+	var plan GrantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current []string
+	if !req.State.Raw.IsNull() {
+		var state GrantResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !plan.DeveloperID.Equal(state.DeveloperID) || !plan.Email.Equal(state.Email) || !plan.PackageName.Equal(state.PackageName) {
+			resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "replace", fmt.Sprintf("grant %q", state.GetName()))...)
+		} else {
+			var diags diag.Diagnostics
+			current, diags = lib.TFListToList[string](ctx, state.AppLevelPermissions)
+			resp.Diagnostics.Append(diags...)
+		}
+	}
+
+	if plan.AppLevelPermissions.IsUnknown() || plan.PackageName.IsUnknown() {
+		return
+	}
+	planned, diags := lib.TFListToList[string](ctx, plan.AppLevelPermissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	change := DiffPermissions(plan.Email.ValueString(), plan.PackageName.ValueString(), current, planned)
+	resp.Diagnostics.Append(r.CheckEscalations([]PermissionChange{change})...)
+}
+
+func (r *GrantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GrantResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Name = types.StringValue(data.GetName())
+	g, diags := data.ToAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result *androidpublisher.Grant
+	err := r.Mutate(ctx, data.DeveloperID.ValueString(), func() (err error) {
+		result, err = r.Grants.Create(ctx, data.GetParent(), g)
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating grant", fmt.Sprintf("Unable to create grant: %v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, result)...)
+
+	tflog.Trace(ctx, "created a grant resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GrantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GrantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.FindUser(ctx, data.DeveloperID.ValueString(), data.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading grant", fmt.Sprintf("Unable to read grant: %v", err))
+		return
+	}
+	if user == nil {
+		resp.Diagnostics.AddError("Could not find user", fmt.Sprintf("Could not find user %q for grant %q", data.Email.ValueString(), data.GetName()))
+		return
+	}
+
+	result := findGrant(user.Grants, data.PackageName.ValueString())
+	if result == nil {
+		resp.Diagnostics.AddError("Could not find grant", fmt.Sprintf("Could not find grant %q", data.GetName()))
+		return
+	}
+	resp.Diagnostics.Append(data.FromAPI(ctx, result)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// grantUpdateFields maps the attributes of a grant that can be patched to their API fields.
+var grantUpdateFields = mask.Fields{
+	"app_level_permissions": "appLevelPermissions",
+}
+
+func (r *GrantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state GrantResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, diags := lib.TFListToList[string](ctx, data.AppLevelPermissions)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	g := &androidpublisher.Grant{
+		AppLevelPermissions: permissions,
+	}
+
+	updateMask, diags := mask.Diff(ctx, &data, &state, grantUpdateFields)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(updateMask) > 0 {
+		var result *androidpublisher.Grant
+		err := r.Mutate(ctx, data.DeveloperID.ValueString(), func() (err error) {
+			result, err = r.Grants.Patch(ctx, data.GetName(), g, updateMask.String())
+			return err
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating grant", fmt.Sprintf("Unable to update grant: %v", err))
+			return
+		}
+		resp.Diagnostics.Append(data.FromAPI(ctx, result)...)
+	}
+
+	tflog.Trace(ctx, "updated a grant resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GrantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GrantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkDeletionProtection(data.DeletionProtection, "destroy", fmt.Sprintf("grant %q", data.GetName()))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.Mutate(ctx, data.DeveloperID.ValueString(), func() error {
+		return r.Grants.Delete(ctx, data.GetName())
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting grant", fmt.Sprintf("Unable to delete grant: %v", err))
+		return
+	}
+}
+
+func (r *GrantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name, err := names.ParseGrant(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("developer_id"), name.DeveloperID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), name.Email)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_name"), name.PackageName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccGrantResourceConfig(permissions string) string {
+	return fmt.Sprintf(`
+resource "androidpublisher_user" "test" {
+  email = %[1]q
+  developer_id = %[2]q
+  developer_account_permissions = [ "CAN_VIEW_APP_QUALITY_GLOBAL"]
+  deletion_protection = false
+}
+
+resource "androidpublisher_grant" "test" {
+  email = androidpublisher_user.test.email
+  developer_id = %[2]q
+  package_name = %[3]q
+  app_level_permissions = %[4]s
+  deletion_protection = false
+}
+`, env.TestEmail, env.TestDeveloperId, env.TestPackageName, permissions)
+}
+
+func TestAccGrantResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckPackageName(t) },
+		ProtoV6ProviderFactories: testAccCassetteProviderFactories(t),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGrantResourceConfig(`["CAN_REPLY_TO_REVIEWS"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("androidpublisher_grant.test", "email", env.TestEmail),
+					resource.TestCheckResourceAttr("androidpublisher_grant.test", "package_name", env.TestPackageName),
+					resource.TestCheckResourceAttr("androidpublisher_grant.test", "app_level_permissions.#", "1"),
+					resource.TestCheckResourceAttr("androidpublisher_grant.test", "app_level_permissions.0", "CAN_REPLY_TO_REVIEWS"),
+					resource.TestCheckResourceAttr("androidpublisher_grant.test", "name", fmt.Sprintf("developers/%s/users/%s/grants/%s", env.TestDeveloperId, env.TestEmail, env.TestPackageName)),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "androidpublisher_grant.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("developers/%s/users/%s/grants/%s", env.TestDeveloperId, env.TestEmail, env.TestPackageName),
+				// deletion_protection is not returned by the API.
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccGrantResourceConfig(`["CAN_REPLY_TO_REVIEWS", "CAN_VIEW_APP_QUALITY"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("androidpublisher_grant.test", "app_level_permissions.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
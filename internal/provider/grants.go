@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/apimodel"
+
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+// grantType is the element type of every list of grants.
+var grantType = types.ObjectType{AttrTypes: apimodel.GrantAttrTypes}
+
+// grantsToList returns the grants as a list of apimodel.GrantModel objects.
+// No grants is an empty list.
+func grantsToList(ctx context.Context, grants []*androidpublisher.Grant) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	models := make([]apimodel.GrantModel, 0, len(grants))
+	for _, g := range grants {
+		var model apimodel.GrantModel
+		diags.Append(model.FromAPI(ctx, g)...)
+		models = append(models, model)
+	}
+	list, d := types.ListValueFrom(ctx, grantType, models)
+	diags.Append(d...)
+	return list, diags
+}
+
+// findGrant returns the grant for the given package, or nil if there is none.
+func findGrant(grants []*androidpublisher.Grant, packageName string) *androidpublisher.Grant {
+	for _, g := range grants {
+		if g.PackageName == packageName {
+			return g
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sync"
+)
+
+// defaultMaxConcurrentMutations serializes mutations unless configured otherwise.
+const defaultMaxConcurrentMutations = 1
+
+// mutationLimiter bounds the number of in-flight mutating calls per developer
+// account. The API reports conflicts when users and grants of the same
+// account are changed concurrently.
+type mutationLimiter struct {
+	mu sync.Mutex
+	// limit is the number of mutations allowed per developer account at once.
+	limit int
+	slots map[string]chan struct{}
+}
+
+func (l *mutationLimiter) slot(developerID string) chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.slots == nil {
+		l.slots = make(map[string]chan struct{})
+	}
+	slot, ok := l.slots[developerID]
+	if !ok {
+		limit := l.limit
+		if limit <= 0 {
+			limit = defaultMaxConcurrentMutations
+		}
+		slot = make(chan struct{}, limit)
+		l.slots[developerID] = slot
+	}
+	return slot
+}
+
+// acquire blocks until a mutation slot for the developer account is free or
+// the context is done. The returned function releases the slot.
+func (l *mutationLimiter) acquire(ctx context.Context, developerID string) (func(), error) {
+	slot := l.slot(developerID)
+	select {
+	case slot <- struct{}{}:
+		return func() { <-slot }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Mutate runs fn, which must perform a single call that changes users or
+// grants of the developer account, within the account's mutation limit. The
+// cached user listing is invalidated afterwards, whether or not fn fails.
+func (c *GoogleProviderContext) Mutate(ctx context.Context, developerID string, fn func() error) error {
+	release, err := c.mutations.acquire(ctx, developerID)
+	if err != nil {
+		return err
+	}
+	defer release()
+	defer c.InvalidateUsers(developerID)
+
+	return fn()
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/fakeplay"
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+// concurrencyRecorder wraps a handler and records the highest number of
+// mutating requests in flight at once.
+type concurrencyRecorder struct {
+	next     http.Handler
+	mu       sync.Mutex
+	inFlight int
+	max      int
+}
+
+func (c *concurrencyRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodGet {
+		c.next.ServeHTTP(w, r)
+		return
+	}
+
+	c.mu.Lock()
+	c.inFlight++
+	c.max = max(c.max, c.inFlight)
+	c.mu.Unlock()
+
+	time.Sleep(5 * time.Millisecond)
+	c.next.ServeHTTP(w, r)
+
+	c.mu.Lock()
+	c.inFlight--
+	c.mu.Unlock()
+}
+
+func createUsersConcurrently(t *testing.T, c *GoogleProviderContext, count int) {
+	t.Helper()
+
+	var wg sync.WaitGroup
+	for i := range count {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			user := &androidpublisher.User{Email: fmt.Sprintf("user%d@example.com", i)}
+			err := c.Mutate(context.Background(), "123", func() error {
+				_, err := c.Users.Create(context.Background(), "developers/123", user)
+				return err
+			})
+			if err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestMutateSerializesPerDeveloperAccount(t *testing.T) {
+	recorder := &concurrencyRecorder{next: fakeplay.New()}
+	c := newTestProviderContext(t, recorder)
+
+	createUsersConcurrently(t, c, 10)
+
+	if recorder.max != 1 {
+		t.Errorf("expected mutations to be serialized, saw %d in flight at once", recorder.max)
+	}
+	users, err := c.ListUsers(context.Background(), "123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 10 {
+		t.Errorf("expected 10 users, got %d", len(users))
+	}
+}
+
+func TestMutateHonorsLimit(t *testing.T) {
+	recorder := &concurrencyRecorder{next: fakeplay.New()}
+	c := newTestProviderContext(t, recorder)
+	c.mutations = mutationLimiter{limit: 3}
+
+	createUsersConcurrently(t, c, 12)
+
+	if recorder.max > 3 {
+		t.Errorf("expected at most 3 mutations in flight, saw %d", recorder.max)
+	}
+}
+
+func TestMutateDoesNotBlockReadsOrOtherAccounts(t *testing.T) {
+	_, c := newTestFakePlay(t)
+
+	release, err := c.mutations.acquire(context.Background(), "123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := c.ListUsers(ctx, "123"); err != nil {
+		t.Errorf("expected reads to proceed while a mutation is in flight: %v", err)
+	}
+	if err := c.Mutate(ctx, "456", func() error { return nil }); err != nil {
+		t.Errorf("expected other developer accounts to proceed: %v", err)
+	}
+
+	blocked, cancelBlocked := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancelBlocked()
+	if err := c.Mutate(blocked, "123", func() error { return nil }); err == nil {
+		t.Error("expected a second mutation of the same account to wait for the first")
+	}
+}
@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/names"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ function.Function = &UserNameFunction{}
+	_ function.Function = &ParseUserNameFunction{}
+	_ function.Function = &GrantNameFunction{}
+	_ function.Function = &ParseGrantNameFunction{}
+	_ function.Function = &EditNameFunction{}
+)
+
+// UserNameAttrTypes are the attribute types of a parsed user name.
+var UserNameAttrTypes = map[string]attr.Type{
+	"developer_id": types.StringType,
+	"email":        types.StringType,
+}
+
+// GrantNameAttrTypes are the attribute types of a parsed grant name.
+var GrantNameAttrTypes = map[string]attr.Type{
+	"developer_id": types.StringType,
+	"email":        types.StringType,
+	"package_name": types.StringType,
+}
+
+// validateArguments returns an argument error for the first argument that fails its check.
+func validateArguments(checks ...func() error) *function.FuncError {
+	for i, check := range checks {
+		if err := check(); err != nil {
+			return function.NewArgumentFuncError(int64(i), err.Error())
+		}
+	}
+	return nil
+}
+
+func NewUserNameFunction() function.Function {
+	return &UserNameFunction{}
+}
+
+// UserNameFunction formats the resource name of a user.
+type UserNameFunction struct{}
+
+func (f *UserNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "user_name"
+}
+
+func (f *UserNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Formats the resource name of a user",
+		MarkdownDescription: "Returns the resource name of a user, following the pattern `developers/{developer}/users/{email}`. The developer ID must be numeric and the email must be a valid address.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "developer_id",
+				MarkdownDescription: "The ID of the developer account",
+			},
+			function.StringParameter{
+				Name:                "email",
+				MarkdownDescription: "The user's email address",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *UserNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var developerID, email string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &developerID, &email))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = validateArguments(
+		func() error { return names.ValidateDeveloperID(developerID) },
+		func() error { return names.ValidateEmail(email) },
+	)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, names.User{DeveloperID: developerID, Email: email}.String()))
+}
+
+func NewParseUserNameFunction() function.Function {
+	return &ParseUserNameFunction{}
+}
+
+// ParseUserNameFunction splits the resource name of a user into its components.
+type ParseUserNameFunction struct{}
+
+func (f *ParseUserNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_user_name"
+}
+
+func (f *ParseUserNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parses the resource name of a user",
+		MarkdownDescription: "Returns an object with the `developer_id` and `email` of a user resource name in the format `developers/{developer}/users/{email}`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The resource name of the user",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: UserNameAttrTypes,
+		},
+	}
+}
+
+func (f *ParseUserNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	user, err := names.ParseUser(name)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(UserNameAttrTypes, map[string]attr.Value{
+		"developer_id": types.StringValue(user.DeveloperID),
+		"email":        types.StringValue(user.Email),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+func NewGrantNameFunction() function.Function {
+	return &GrantNameFunction{}
+}
+
+// GrantNameFunction formats the resource name of a grant.
+type GrantNameFunction struct{}
+
+func (f *GrantNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "grant_name"
+}
+
+func (f *GrantNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Formats the resource name of a grant",
+		MarkdownDescription: "Returns the resource name of a grant, following the pattern `developers/{developer}/users/{email}/grants/{package_name}`. The developer ID must be numeric, the email must be a valid address and the package name must be a valid Android package name.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "developer_id",
+				MarkdownDescription: "The ID of the developer account",
+			},
+			function.StringParameter{
+				Name:                "email",
+				MarkdownDescription: "The user's email address",
+			},
+			function.StringParameter{
+				Name:                "package_name",
+				MarkdownDescription: "The package name of the app",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *GrantNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var developerID, email, packageName string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &developerID, &email, &packageName))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = validateArguments(
+		func() error { return names.ValidateDeveloperID(developerID) },
+		func() error { return names.ValidateEmail(email) },
+		func() error { return names.ValidatePackageName(packageName) },
+	)
+	if resp.Error != nil {
+		return
+	}
+
+	grantName := names.Grant{DeveloperID: developerID, Email: email, PackageName: packageName}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, grantName.String()))
+}
+
+func NewParseGrantNameFunction() function.Function {
+	return &ParseGrantNameFunction{}
+}
+
+// ParseGrantNameFunction splits the resource name of a grant into its components.
+type ParseGrantNameFunction struct{}
+
+func (f *ParseGrantNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_grant_name"
+}
+
+func (f *ParseGrantNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parses the resource name of a grant",
+		MarkdownDescription: "Returns an object with the `developer_id`, `email` and `package_name` of a grant resource name in the format `developers/{developer}/users/{email}/grants/{package_name}`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The resource name of the grant",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: GrantNameAttrTypes,
+		},
+	}
+}
+
+func (f *ParseGrantNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	grantName, err := names.ParseGrant(name)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(GrantNameAttrTypes, map[string]attr.Value{
+		"developer_id": types.StringValue(grantName.DeveloperID),
+		"email":        types.StringValue(grantName.Email),
+		"package_name": types.StringValue(grantName.PackageName),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+func NewEditNameFunction() function.Function {
+	return &EditNameFunction{}
+}
+
+// EditNameFunction formats the resource name of an app edit.
+type EditNameFunction struct{}
+
+func (f *EditNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "edit_name"
+}
+
+func (f *EditNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Formats the resource name of an app edit",
+		MarkdownDescription: "Returns the resource name of an app edit, following the pattern `applications/{package_name}/edits/{edit_id}`. The package name must be a valid Android package name.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "package_name",
+				MarkdownDescription: "The package name of the app",
+			},
+			function.StringParameter{
+				Name:                "edit_id",
+				MarkdownDescription: "The ID of the edit",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *EditNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var packageName, editID string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &packageName, &editID))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = validateArguments(
+		func() error { return names.ValidatePackageName(packageName) },
+		func() error { return names.ValidateSegment("edit ID", editID) },
+	)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, names.Edit{PackageName: packageName, EditID: editID}.String()))
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func runFunction(t *testing.T, f function.Function, result attr.Value, args ...string) function.RunResponse {
+	t.Helper()
+
+	arguments := make([]attr.Value, len(args))
+	for i, arg := range args {
+		arguments[i] = types.StringValue(arg)
+	}
+
+	resp := function.RunResponse{
+		Result: function.NewResultData(result),
+	}
+	f.Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData(arguments),
+	}, &resp)
+	return resp
+}
+
+func TestNameFunctionsFormat(t *testing.T) {
+	tests := map[string]struct {
+		function function.Function
+		args     []string
+		expected string
+	}{
+		"user_name": {
+			function: NewUserNameFunction(),
+			args:     []string{"1234567891234567891", "someone@example.com"},
+			expected: "developers/1234567891234567891/users/someone@example.com",
+		},
+		"grant_name": {
+			function: NewGrantNameFunction(),
+			args:     []string{"1234567891234567891", "someone@example.com", "com.example.app"},
+			expected: "developers/1234567891234567891/users/someone@example.com/grants/com.example.app",
+		},
+		"edit_name": {
+			function: NewEditNameFunction(),
+			args:     []string{"com.example.app", "1716211424394"},
+			expected: "applications/com.example.app/edits/1716211424394",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := runFunction(t, tt.function, types.StringUnknown(), tt.args...)
+			if resp.Error != nil {
+				t.Fatal(resp.Error)
+			}
+			if !resp.Result.Value().Equal(types.StringValue(tt.expected)) {
+				t.Errorf("expected %q, got %s", tt.expected, resp.Result.Value())
+			}
+		})
+	}
+}
+
+func TestNameFunctionsInvalidArguments(t *testing.T) {
+	tests := map[string]struct {
+		function function.Function
+		args     []string
+		argument int64
+	}{
+		"user_name developer ID": {
+			function: NewUserNameFunction(),
+			args:     []string{"developers/123", "someone@example.com"},
+			argument: 0,
+		},
+		"user_name email": {
+			function: NewUserNameFunction(),
+			args:     []string{"123", "someone"},
+			argument: 1,
+		},
+		"grant_name package name": {
+			function: NewGrantNameFunction(),
+			args:     []string{"123", "someone@example.com", "app"},
+			argument: 2,
+		},
+		"edit_name edit ID": {
+			function: NewEditNameFunction(),
+			args:     []string{"com.example.app", ""},
+			argument: 1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := runFunction(t, tt.function, types.StringUnknown(), tt.args...)
+			if resp.Error == nil {
+				t.Fatal("expected an error")
+			}
+			if resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != tt.argument {
+				t.Errorf("expected the error to point at argument %d, got %v", tt.argument, resp.Error.FunctionArgument)
+			}
+		})
+	}
+}
+
+func TestParseUserNameFunction(t *testing.T) {
+	resp := runFunction(t, NewParseUserNameFunction(), types.ObjectUnknown(UserNameAttrTypes), "developers/123/users/someone@example.com")
+	if resp.Error != nil {
+		t.Fatal(resp.Error)
+	}
+
+	expected := types.ObjectValueMust(UserNameAttrTypes, map[string]attr.Value{
+		"developer_id": types.StringValue("123"),
+		"email":        types.StringValue("someone@example.com"),
+	})
+	if !resp.Result.Value().Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, resp.Result.Value())
+	}
+}
+
+func TestParseGrantNameFunction(t *testing.T) {
+	resp := runFunction(t, NewParseGrantNameFunction(), types.ObjectUnknown(GrantNameAttrTypes), "developers/123/users/someone@example.com/grants/com.example.app")
+	if resp.Error != nil {
+		t.Fatal(resp.Error)
+	}
+
+	expected := types.ObjectValueMust(GrantNameAttrTypes, map[string]attr.Value{
+		"developer_id": types.StringValue("123"),
+		"email":        types.StringValue("someone@example.com"),
+		"package_name": types.StringValue("com.example.app"),
+	})
+	if !resp.Result.Value().Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, resp.Result.Value())
+	}
+}
+
+func TestParseNameFunctionsInvalid(t *testing.T) {
+	tests := map[string]struct {
+		function function.Function
+		result   attr.Value
+		name     string
+	}{
+		"user name with grant suffix": {
+			function: NewParseUserNameFunction(),
+			result:   types.ObjectUnknown(UserNameAttrTypes),
+			name:     "developers/123/users/someone@example.com/grants/com.example.app",
+		},
+		"user name with invalid email": {
+			function: NewParseUserNameFunction(),
+			result:   types.ObjectUnknown(UserNameAttrTypes),
+			name:     "developers/123/users/someone",
+		},
+		"grant name without package": {
+			function: NewParseGrantNameFunction(),
+			result:   types.ObjectUnknown(GrantNameAttrTypes),
+			name:     "developers/123/users/someone@example.com/grants/",
+		},
+		"grant name with non-numeric developer": {
+			function: NewParseGrantNameFunction(),
+			result:   types.ObjectUnknown(GrantNameAttrTypes),
+			name:     "developers/abc/users/someone@example.com/grants/com.example.app",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := runFunction(t, tt.function, tt.result, tt.name)
+			if resp.Error == nil {
+				t.Fatal("expected an error")
+			}
+			if resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 0 {
+				t.Errorf("expected the error to point at the name argument, got %v", resp.Error.FunctionArgument)
+			}
+		})
+	}
+}
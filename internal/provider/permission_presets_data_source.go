@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/permissions"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PermissionPresetsDataSource{}
+
+func NewPermissionPresetsDataSource() datasource.DataSource {
+	return &PermissionPresetsDataSource{}
+}
+
+// PermissionPresetsDataSource defines the data source implementation.
+type PermissionPresetsDataSource struct {
+	*GoogleProviderContext
+}
+
+// PermissionPresetsDataModel describes the data source data model.
+type PermissionPresetsDataModel struct {
+	Roles types.Map `tfsdk:"roles"`
+}
+
+func (d *PermissionPresetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_presets"
+}
+
+func (d *PermissionPresetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+
+		MarkdownDescription: "Expands named roles into developer account and app-level permission sets. Includes the built-in roles and any `custom_roles` from the provider configuration.",
+
+		Attributes: map[string]schema.Attribute{
+			"roles": schema.MapNestedAttribute{
+				MarkdownDescription: "The permissions of each role, keyed by role name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"developer_account_permissions": schema.ListAttribute{
+							MarkdownDescription: "The developer account permissions of the role, for use in `androidpublisher_user`",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"app_level_permissions": schema.ListAttribute{
+							MarkdownDescription: "The app-level permissions of the role, for use in grants",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *PermissionPresetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	gCtx, ok := req.ProviderData.(*GoogleProviderContext)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GoogleProviderContext, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.GoogleProviderContext = gCtx
+}
+
+func (d *PermissionPresetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PermissionPresetsDataModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles := permissions.BuiltinRoles
+	if d.GoogleProviderContext != nil && d.Roles != nil {
+		roles = d.Roles
+	}
+
+	elements := make(map[string]attr.Value, len(roles))
+	for name, role := range roles {
+		value, diags := RoleToTfModel(role)
+		resp.Diagnostics.Append(diags...)
+		elements[name] = value
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, diags := types.MapValue(types.ObjectType{AttrTypes: RoleAttrTypes}, elements)
+	resp.Diagnostics.Append(diags...)
+	data.Roles = value
+
+	tflog.Trace(ctx, "read permission presets data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
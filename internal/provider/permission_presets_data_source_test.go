@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPermissionPresetsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "androidpublisher" {
+  custom_roles = {
+    qa = {
+      developer_account_permissions = ["CAN_MANAGE_TRACK_USERS_GLOBAL"]
+      app_level_permissions         = ["CAN_MANAGE_TRACK_USERS"]
+    }
+  }
+}
+
+data "androidpublisher_permission_presets" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.androidpublisher_permission_presets.test", "roles.qa.app_level_permissions.0", "CAN_MANAGE_TRACK_USERS"),
+					resource.TestCheckResourceAttr("data.androidpublisher_permission_presets.test", "roles.finance_viewer.developer_account_permissions.0", "CAN_VIEW_FINANCIAL_DATA_GLOBAL"),
+				),
+			},
+			{
+				Config: `
+provider "androidpublisher" {
+  custom_roles = {
+    qa = {
+      developer_account_permissions = ["CAN_DO_ANYTHING_GLOBAL"]
+    }
+  }
+}
+
+data "androidpublisher_permission_presets" "test" {}
+`,
+				ExpectError: regexp.MustCompile("Invalid custom role"),
+			},
+		},
+	})
+}
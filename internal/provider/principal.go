@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/names"
+)
+
+// Principal types of the identities that can be given access.
+const (
+	PrincipalTypeUser           = "user"
+	PrincipalTypeGroup          = "group"
+	PrincipalTypeServiceAccount = "service_account"
+)
+
+var PrincipalTypes = []string{PrincipalTypeUser, PrincipalTypeGroup, PrincipalTypeServiceAccount}
+
+// serviceAccountDomain is the domain suffix of every Google Cloud service
+// account, e.g. iam.gserviceaccount.com or developer.gserviceaccount.com.
+const serviceAccountDomain = ".gserviceaccount.com"
+
+func isServiceAccountEmail(email string) bool {
+	return strings.HasSuffix(strings.ToLower(email), serviceAccountDomain)
+}
+
+// InferPrincipalType derives the principal type from an email address.
+// Groups cannot be told apart from users by address, so only the given group
+// emails are reported as groups.
+func InferPrincipalType(email string, groupEmails []string) string {
+	switch {
+	case isServiceAccountEmail(email):
+		return PrincipalTypeServiceAccount
+	case slices.ContainsFunc(groupEmails, func(group string) bool { return strings.EqualFold(group, email) }):
+		return PrincipalTypeGroup
+	default:
+		return PrincipalTypeUser
+	}
+}
+
+// ValidatePrincipal checks that the email is a valid address for the
+// principal type. An empty principal type only validates the address.
+func ValidatePrincipal(principalType string, email string) error {
+	if principalType != "" && !slices.Contains(PrincipalTypes, principalType) {
+		return fmt.Errorf("unknown principal type %q, expected one of: %s", principalType, strings.Join(PrincipalTypes, ", "))
+	}
+
+	if err := names.ValidateEmail(email); err != nil {
+		return err
+	}
+
+	switch {
+	case principalType == PrincipalTypeServiceAccount && !isServiceAccountEmail(email):
+		return fmt.Errorf("%q is not a service account address, which must end with %s", email, serviceAccountDomain)
+	case principalType != PrincipalTypeServiceAccount && principalType != "" && isServiceAccountEmail(email):
+		return fmt.Errorf("%q is a service account address, set principal_type to %q", email, PrincipalTypeServiceAccount)
+	}
+	return nil
+}
+
+// PrincipalType infers the principal type of an email using the provider's group emails.
+func (c *GoogleProviderContext) PrincipalType(email string) string {
+	var groupEmails []string
+	if c != nil {
+		groupEmails = c.GroupEmails
+	}
+	return InferPrincipalType(email, groupEmails)
+}
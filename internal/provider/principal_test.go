@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestInferPrincipalType(t *testing.T) {
+	groups := []string{"Release-Team@example.com"}
+	tests := map[string]string{
+		"dev@example.com":                                   PrincipalTypeUser,
+		"release-team@example.com":                          PrincipalTypeGroup,
+		"ci@my-project.iam.gserviceaccount.com":             PrincipalTypeServiceAccount,
+		"123456-compute@developer.gserviceaccount.com":      PrincipalTypeServiceAccount,
+		"my-project@appspot.gserviceaccount.com":            PrincipalTypeServiceAccount,
+		"not-a-service-account@gserviceaccount.example.com": PrincipalTypeUser,
+	}
+	for email, want := range tests {
+		if got := InferPrincipalType(email, groups); got != want {
+			t.Errorf("%s: expected %s, got %s", email, want, got)
+		}
+	}
+}
+
+func TestValidatePrincipal(t *testing.T) {
+	tests := []struct {
+		principalType string
+		email         string
+		valid         bool
+	}{
+		{"", "dev@example.com", true},
+		{"", "ci@my-project.iam.gserviceaccount.com", true},
+		{"", "not an email", false},
+		{"", "Dev <dev@example.com>", false},
+		{PrincipalTypeUser, "dev@example.com", true},
+		{PrincipalTypeGroup, "team@example.com", true},
+		{PrincipalTypeGroup, "ci@my-project.iam.gserviceaccount.com", false},
+		{PrincipalTypeServiceAccount, "ci@my-project.iam.gserviceaccount.com", true},
+		{PrincipalTypeServiceAccount, "dev@example.com", false},
+		{"robot", "dev@example.com", false},
+	}
+	for _, tt := range tests {
+		err := ValidatePrincipal(tt.principalType, tt.email)
+		if (err == nil) != tt.valid {
+			t.Errorf("ValidatePrincipal(%q, %q): expected valid %t, got %v", tt.principalType, tt.email, tt.valid, err)
+		}
+	}
+}
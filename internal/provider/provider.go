@@ -5,18 +5,30 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"google.golang.org/api/androidpublisher/v3"
+	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
 	"net/http"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/lib"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/permissions"
 
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure GoogleProvider satisfies various provider interfaces.
 var _ provider.Provider = &GoogleProvider{}
+var _ provider.ProviderWithFunctions = &GoogleProvider{}
 
 // GoogleProvider defines the provider implementation.
 type GoogleProvider struct {
@@ -24,15 +36,50 @@ type GoogleProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+	// clientOptions are passed to the Android Publisher service, such as
+	// the endpoint of a fake API server used by the acceptance tests.
+	clientOptions []option.ClientOption
+	// wrapTransport wraps the authenticated transport of the Android
+	// Publisher service, such as to record or replay the API traffic of the
+	// acceptance tests.
+	wrapTransport func(http.RoundTripper) http.RoundTripper
 }
 
 // GoogleProviderModel describes the provider data model.
 type GoogleProviderModel struct {
+	CallerEmail            types.String               `tfsdk:"caller_email"`
+	CustomRoles            map[string]CustomRoleModel `tfsdk:"custom_roles"`
+	EscalationErrors       types.Set                  `tfsdk:"escalation_errors"`
+	MaxConcurrentMutations types.Int64                `tfsdk:"max_concurrent_mutations"`
+	GroupEmails            types.Set                  `tfsdk:"group_emails"`
+}
+
+// CustomRoleModel describes a role defined in the provider configuration.
+type CustomRoleModel struct {
+	DeveloperAccountPermissions types.List `tfsdk:"developer_account_permissions"`
+	AppLevelPermissions         types.List `tfsdk:"app_level_permissions"`
 }
 
 type GoogleProviderContext struct {
 	Client                  *http.Client
 	AndroidPublisherService *androidpublisher.Service
+	// Users and Grants call the API. They wrap AndroidPublisherService and are replaced by fakes in unit tests.
+	Users  UsersClient
+	Grants GrantsClient
+	// CallerEmail is the identity the provider authenticates as, or empty if unknown.
+	CallerEmail string
+	// Roles are the built-in permission presets merged with the custom roles from the provider configuration.
+	Roles map[string]permissions.Role
+	// EscalationErrors are the sensitive permissions whose addition fails the plan instead of warning.
+	EscalationErrors []string
+	// GroupEmails are the addresses of Google Groups, which cannot be told apart from users by the API.
+	GroupEmails []string
+
+	userCache userCache
+	mutations mutationLimiter
+	// adminRemovals serializes the changes that remove an admin, whatever the
+	// mutation limit, so each one is checked against the result of the last.
+	adminRemovals mutationLimiter
 }
 
 func (p *GoogleProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -43,7 +90,55 @@ func (p *GoogleProvider) Metadata(ctx context.Context, req provider.MetadataRequ
 func (p *GoogleProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Interacts with Google Play Developer APIs. https://developers.google.com/android-publisher",
+		Attributes: map[string]schema.Attribute{
+			"caller_email": schema.StringAttribute{
+				MarkdownDescription: "The email of the identity the provider authenticates as. Used to keep resources from removing the provider's own access. Detected from service account credentials when unset.",
+				Optional:            true,
+			},
+			"custom_roles": schema.MapNestedAttribute{
+				MarkdownDescription: "Additional permission presets, keyed by role name, made available through the `androidpublisher_permission_presets` data source. Permissions are validated against the values known to the API. Names must not collide with a built-in role.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"developer_account_permissions": schema.ListAttribute{
+							MarkdownDescription: "The developer account permissions of the role",
+							Optional:            true,
+							ElementType:         types.StringType,
+						},
+						"app_level_permissions": schema.ListAttribute{
+							MarkdownDescription: "The app-level permissions of the role",
+							Optional:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+			"escalation_errors": schema.SetAttribute{
+				MarkdownDescription: "Sensitive permissions, such as `CAN_MANAGE_PERMISSIONS_GLOBAL`, whose addition to any user fails the plan instead of producing a privilege escalation warning. Permissions that are not sensitive are rejected, as they never produce a warning.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"group_emails": schema.SetAttribute{
+				MarkdownDescription: "Email addresses of Google Groups that have access. The API does not distinguish groups from individual users, so these addresses are reported with principal type `group` where the type is inferred.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"max_concurrent_mutations": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of calls that change users or grants of the same developer account at once. Reads are not limited. Defaults to 1, which serializes changes to avoid conflict errors from the API.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// CheckEscalations reports the sensitive permissions the changes add,
+// following the provider's escalation_errors setting.
+func (c *GoogleProviderContext) CheckEscalations(changes []PermissionChange) diag.Diagnostics {
+	var errorOn []string
+	if c != nil {
+		errorOn = c.EscalationErrors
 	}
+	return EscalationDiagnostics(changes, errorOn)
 }
 
 func (p *GoogleProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -55,15 +150,89 @@ func (p *GoogleProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	service, err := androidpublisher.NewService(ctx)
+	customRoles := make(map[string]permissions.Role, len(data.CustomRoles))
+	for name, role := range data.CustomRoles {
+		developerAccountPermissions, diags := lib.TFListToList[string](ctx, role.DeveloperAccountPermissions)
+		resp.Diagnostics.Append(diags...)
+		appLevelPermissions, diags := lib.TFListToList[string](ctx, role.AppLevelPermissions)
+		resp.Diagnostics.Append(diags...)
+		customRoles[name] = permissions.Role{
+			DeveloperAccountPermissions: developerAccountPermissions,
+			AppLevelPermissions:         appLevelPermissions,
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var escalationErrors []string
+	resp.Diagnostics.Append(data.EscalationErrors.ElementsAs(ctx, &escalationErrors, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if invalid := permissions.NotSensitive(escalationErrors); len(invalid) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("escalation_errors"),
+			"Invalid escalation_errors",
+			fmt.Sprintf("Only sensitive permissions produce escalation warnings, got: %s. Expected any of: %s", strings.Join(invalid, ", "), strings.Join(permissions.Sensitive, ", ")),
+		)
+		return
+	}
+
+	var groupEmails []string
+	resp.Diagnostics.Append(data.GroupEmails.ElementsAs(ctx, &groupEmails, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxConcurrentMutations := int64(defaultMaxConcurrentMutations)
+	if !data.MaxConcurrentMutations.IsNull() {
+		maxConcurrentMutations = data.MaxConcurrentMutations.ValueInt64()
+	}
+	if maxConcurrentMutations < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_concurrent_mutations"), "Invalid max_concurrent_mutations", fmt.Sprintf("Expected at least 1, got %d.", maxConcurrentMutations))
+		return
+	}
+
+	roles, err := permissions.WithCustomRoles(customRoles)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("custom_roles"), "Invalid custom role", fmt.Sprintf("Unable to use custom roles: %v", err))
+		return
+	}
+
+	client := http.DefaultClient
+	options := slices.Clone(p.clientOptions)
+	if p.wrapTransport != nil {
+		transport, err := htransport.NewTransport(ctx, http.DefaultTransport, append([]option.ClientOption{option.WithScopes(androidpublisher.AndroidpublisherScope)}, p.clientOptions...)...)
+		if err != nil {
+			resp.Diagnostics.AddError("Error creating Android Publisher transport", fmt.Sprintf("Unable to create transport: %v", err))
+			return
+		}
+		client = &http.Client{Transport: p.wrapTransport(transport)}
+		options = append(options, option.WithHTTPClient(client))
+	}
+
+	service, err := androidpublisher.NewService(ctx, options...)
 	if err != nil {
 		resp.Diagnostics.AddError("error creating Android Publisher service: %s", err.Error())
 		return
 	}
 
+	callerEmail := data.CallerEmail.ValueString()
+	if callerEmail == "" {
+		callerEmail = DetectCallerEmail(ctx)
+	}
+
 	providerContext := &GoogleProviderContext{
-		Client:                  http.DefaultClient,
+		Client:                  client,
 		AndroidPublisherService: service,
+		Users:                   NewUsersClient(service),
+		Grants:                  NewGrantsClient(service),
+		CallerEmail:             callerEmail,
+		Roles:                   roles,
+		EscalationErrors:        escalationErrors,
+		GroupEmails:             groupEmails,
+		mutations:               mutationLimiter{limit: int(maxConcurrentMutations)},
 	}
 
 	resp.DataSourceData = providerContext
@@ -74,12 +243,30 @@ func (p *GoogleProvider) Configure(ctx context.Context, req provider.ConfigureRe
 func (p *GoogleProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewUserResource,
+		NewGrantResource,
+		NewDeveloperAccountUsersResource,
+		NewAppAccessPolicyResource,
 	}
 }
 
 func (p *GoogleProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewUserDataSource,
+		NewAppAccessDataSource,
+		NewUserByEmailDataSource,
+		NewPermissionPresetsDataSource,
+		NewAccessReviewDataSource,
+	}
+}
+
+func (p *GoogleProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewRolePermissionsFunction,
+		NewUserNameFunction,
+		NewParseUserNameFunction,
+		NewGrantNameFunction,
+		NewParseGrantNameFunction,
+		NewEditNameFunction,
 	}
 }
 
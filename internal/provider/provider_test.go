@@ -4,11 +4,25 @@
 package provider
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/cassette"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/fakeplay"
+	"google.golang.org/api/option"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -16,19 +30,152 @@ import (
 // CLI command executed to create a provider server to which the CLI can
 // reattach.
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"androidpublisher": providerserver.NewProtocol6WithError(New("test")()),
+	"androidpublisher": func() (tfprotov6.ProviderServer, error) {
+		return providerserver.NewProtocol6WithError(testAccProvider())()
+	},
+}
+
+// liveTestsEnvVar opts in to running the acceptance tests against the real
+// API. Without it they run against an in-process fakeplay server.
+const liveTestsEnvVar = "ANDROIDPUBLISHER_LIVE_TESTS"
+
+// Values the acceptance tests use against the fake API.
+const (
+	fakeDeveloperID = "1234567891234567891"
+	fakeTestEmail   = "tester@example.com"
+	fakeOwnerEmail  = "owner@example.com"
+	fakePackageName = "com.example.app"
+)
+
+func testAccLive() bool {
+	return os.Getenv(liveTestsEnvVar) != ""
+}
+
+var testAccFake struct {
+	once   sync.Once
+	play   *fakeplay.Server
+	server *httptest.Server
+}
+
+// testAccFakeServer starts the fake API shared by the acceptance tests,
+// seeded with an account owner and the test app.
+func testAccFakeServer() *httptest.Server {
+	testAccFake.once.Do(func() {
+		testAccFake.play = fakeplay.New()
+		testAccFake.play.SeedAccount(fakeDeveloperID, fakeOwnerEmail)
+		testAccFake.play.AddApp(fakePackageName)
+		testAccFake.server = httptest.NewServer(testAccFake.play)
+	})
+	return testAccFake.server
+}
+
+// testAccProvider returns the provider under test, which talks to the fake
+// API unless live tests are enabled.
+func testAccProvider() provider.Provider {
+	if testAccLive() {
+		return New("test")()
+	}
+	return &GoogleProvider{
+		version:       "test",
+		clientOptions: fakeplay.ClientOptions(testAccFakeServer().URL),
+	}
+}
+
+// cassetteModeEnvVar makes the acceptance tests that use cassettes record
+// the traffic of the real API ("record") or replay it offline ("replay").
+const cassetteModeEnvVar = "ANDROIDPUBLISHER_CASSETTES"
+
+// cassetteDir holds a cassette for every test that uses one.
+const cassetteDir = "testdata/cassettes"
+
+// testAccCassetteProviderFactories returns the provider factories of a test
+// that can be recorded and replayed. Recording replaces the test values with
+// the ones used against the fake API, so replays use the same values.
+// Without a cassette mode, the factories are testAccProtoV6ProviderFactories.
+func testAccCassetteProviderFactories(t *testing.T) map[string]func() (tfprotov6.ProviderServer, error) {
+	mode, err := cassette.ParseMode(os.Getenv(cassetteModeEnvVar))
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(cassetteDir, strings.ReplaceAll(t.Name(), "/", "_")+".json")
+	sanitizer := cassette.NewSanitizer(map[string]string{
+		env.TestEmail:       fakeTestEmail,
+		env.TestDeveloperId: fakeDeveloperID,
+		env.TestPackageName: fakePackageName,
+	})
+
+	var clientOptions []option.ClientOption
+	var wrapTransport func(http.RoundTripper) http.RoundTripper
+	switch mode {
+	case cassette.ModeRecord:
+		if !testAccLive() {
+			t.Fatalf("%s=%s calls the real API and requires %s", cassetteModeEnvVar, mode, liveTestsEnvVar)
+		}
+		recorder := cassette.NewRecorder(sanitizer)
+		t.Cleanup(func() {
+			// Keep the previous cassette when the recording failed.
+			if t.Failed() {
+				return
+			}
+			if err := recorder.Save(path); err != nil {
+				t.Errorf("Unable to save cassette: %v", err)
+			}
+		})
+		wrapTransport = recorder.Wrap
+	case cassette.ModeReplay:
+		recorded, err := cassette.Load(path)
+		if os.IsNotExist(err) {
+			// Cassettes are recorded against a real account, so none are
+			// committed until a maintainer records them.
+			t.Skipf("No cassette at %s, record it with %s=%s", path, cassetteModeEnvVar, cassette.ModeRecord)
+		}
+		if err != nil {
+			t.Fatalf("Unable to load cassette: %v", err)
+		}
+		replayer := cassette.NewReplayer(recorded, sanitizer)
+		t.Cleanup(func() {
+			if unused := replayer.Unused(); len(unused) > 0 {
+				t.Errorf("%d recorded requests were not sent, starting with %s %s", len(unused), unused[0].Method, unused[0].URL)
+			}
+		})
+		clientOptions = []option.ClientOption{option.WithoutAuthentication()}
+		wrapTransport = replayer.Wrap
+	default:
+		return testAccProtoV6ProviderFactories
+	}
+
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"androidpublisher": func() (tfprotov6.ProviderServer, error) {
+			return providerserver.NewProtocol6WithError(&GoogleProvider{
+				version:       "test",
+				clientOptions: clientOptions,
+				wrapTransport: wrapTransport,
+			})()
+		},
+	}
 }
 
 type EnvironmentVariables struct {
 	TestEmail             string
 	TestDeveloperId       string
+	TestPackageName       string
 	GoogleCredentialsJson string
 }
 
+// NewEnvironmentVariables returns the test values from the environment
+// when live tests are enabled, and fixed values for the fake API otherwise.
 func NewEnvironmentVariables() EnvironmentVariables {
+	if !testAccLive() {
+		return EnvironmentVariables{
+			TestEmail:       fakeTestEmail,
+			TestDeveloperId: fakeDeveloperID,
+			TestPackageName: fakePackageName,
+		}
+	}
 	res := EnvironmentVariables{
 		TestEmail:       os.Getenv("TEST_EMAIL"),
 		TestDeveloperId: os.Getenv("TEST_DEVELOPER_ID"),
+		TestPackageName: os.Getenv("TEST_PACKAGE_NAME"),
 	}
 	return res
 }
@@ -38,6 +185,10 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 
+	if !testAccLive() {
+		return
+	}
+
 	env := NewEnvironmentVariables()
 	var missingVariables []string
 	if env.TestEmail == "" {
@@ -51,3 +202,142 @@ func testAccPreCheck(t *testing.T) {
 	}
 
 }
+
+func testAccPreCheckPackageName(t *testing.T) {
+	testAccPreCheck(t)
+
+	if NewEnvironmentVariables().TestPackageName == "" {
+		t.Fatalf("Environment variables missing: %v", []string{"TEST_PACKAGE_NAME"})
+	}
+}
+
+// configureProvider configures the provider with an empty provider block.
+func configureProvider(ctx context.Context, p provider.Provider) (*GoogleProviderContext, diag.Diagnostics) {
+	return configureProviderWith(ctx, p, GoogleProviderModel{
+		EscalationErrors: types.SetNull(types.StringType),
+		GroupEmails:      types.SetNull(types.StringType),
+	})
+}
+
+// configureProviderWith configures the provider with the given provider block.
+func configureProviderWith(ctx context.Context, p provider.Provider, data GoogleProviderModel) (*GoogleProviderContext, diag.Diagnostics) {
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	// The provider block is built through State as Config cannot be set.
+	block := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := block.Set(ctx, &data); diags.HasError() {
+		return nil, diags
+	}
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: block.Raw}
+
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, provider.ConfigureRequest{Config: config}, &resp)
+	if resp.Diagnostics.HasError() {
+		return nil, resp.Diagnostics
+	}
+	return resp.ResourceData.(*GoogleProviderContext), nil
+}
+
+// testConfigure configures the provider with an empty provider block.
+func testConfigure(t *testing.T, p provider.Provider) *GoogleProviderContext {
+	t.Helper()
+
+	providerContext, diags := configureProvider(context.Background(), p)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	return providerContext
+}
+
+func TestProviderConfigureWithFakeAPI(t *testing.T) {
+	ctx := context.Background()
+	p := testAccProvider()
+	if testAccLive() {
+		t.Skipf("%s is set", liveTestsEnvVar)
+	}
+
+	providerContext := testConfigure(t, p)
+	owner, err := providerContext.FindUser(ctx, fakeDeveloperID, fakeOwnerEmail)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if owner == nil {
+		t.Errorf("expected the fake account owner to be listed")
+	}
+}
+
+func TestProviderConfigureEscalationErrors(t *testing.T) {
+	tests := map[string]struct {
+		permissions []string
+		expectError bool
+	}{
+		"sensitive":     {[]string{"CAN_MANAGE_PERMISSIONS_GLOBAL", "CAN_MANAGE_ORDERS"}, false},
+		"not sensitive": {[]string{"CAN_MANAGE_PERMISSIONS_GLOBAL", "CAN_VIEW_APP_QUALITY_GLOBAL"}, true},
+		"unknown":       {[]string{"CAN_DO_ANYTHING"}, true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if !tt.expectError && testAccLive() {
+				t.Skipf("%s is set", liveTestsEnvVar)
+			}
+			ctx := context.Background()
+			escalationErrors, diags := types.SetValueFrom(ctx, types.StringType, tt.permissions)
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+			_, diags = configureProviderWith(ctx, testAccProvider(), GoogleProviderModel{
+				EscalationErrors: escalationErrors,
+				GroupEmails:      types.SetNull(types.StringType),
+			})
+			if diags.HasError() != tt.expectError {
+				t.Errorf("expected error %t, got %v", tt.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestProviderConfigureRecordsAndReplaysCassettes(t *testing.T) {
+	ctx := context.Background()
+	play := fakeplay.New()
+	play.SeedAccount("123", "owner@corp.example.org")
+	server := httptest.NewServer(play)
+	t.Cleanup(server.Close)
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder := cassette.NewRecorder(cassette.NewSanitizer(map[string]string{"123": fakeDeveloperID}))
+	recording := testConfigure(t, &GoogleProvider{
+		clientOptions: fakeplay.ClientOptions(server.URL),
+		wrapTransport: recorder.Wrap,
+	})
+	if _, err := recording.FindUser(ctx, "123", "owner@corp.example.org"); err != nil {
+		t.Fatal(err)
+	}
+	if err := recorder.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	recorded, err := cassette.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// As in the acceptance tests, replays know the values that were replaced.
+	replayer := cassette.NewReplayer(recorded, cassette.NewSanitizer(map[string]string{fakeDeveloperID: fakeDeveloperID}))
+	// Replays call no server, so the endpoint is left to its default.
+	replaying := testConfigure(t, &GoogleProvider{
+		clientOptions: []option.ClientOption{option.WithoutAuthentication()},
+		wrapTransport: replayer.Wrap,
+	})
+	users, err := replaying.ListUsers(ctx, fakeDeveloperID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 1 || users[0].Email != "user1@example.com" {
+		t.Errorf("expected the sanitized owner to be replayed, got %+v", users)
+	}
+	if unused := replayer.Unused(); len(unused) != 0 {
+		t.Errorf("expected every recorded request to be replayed, got %+v", unused)
+	}
+}
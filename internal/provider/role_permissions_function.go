@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/lib"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/permissions"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &RolePermissionsFunction{}
+
+// RoleAttrTypes are the attribute types of a permission preset object.
+var RoleAttrTypes = map[string]attr.Type{
+	"developer_account_permissions": types.ListType{ElemType: types.StringType},
+	"app_level_permissions":         types.ListType{ElemType: types.StringType},
+}
+
+// RoleToTfModel converts a role to a permission preset object.
+func RoleToTfModel(role permissions.Role) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(RoleAttrTypes, map[string]attr.Value{
+		"developer_account_permissions": lib.StrListToTfModel(role.DeveloperAccountPermissions),
+		"app_level_permissions":         lib.StrListToTfModel(role.AppLevelPermissions),
+	})
+}
+
+func NewRolePermissionsFunction() function.Function {
+	return &RolePermissionsFunction{}
+}
+
+// RolePermissionsFunction expands a built-in role into its permissions.
+type RolePermissionsFunction struct{}
+
+func (f *RolePermissionsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "role_permissions"
+}
+
+func (f *RolePermissionsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Expands a built-in role into its permissions",
+		MarkdownDescription: fmt.Sprintf(
+			"Returns an object with the `developer_account_permissions` and `app_level_permissions` of a built-in role. "+
+				"Provider functions cannot read the provider configuration, so custom roles are only available through the `androidpublisher_permission_presets` data source. "+
+				"Built-in roles: %s.",
+			"`"+strings.Join(permissions.RoleNames(permissions.BuiltinRoles), "`, `")+"`",
+		),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The name of the role",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: RoleAttrTypes,
+		},
+	}
+}
+
+func (f *RolePermissionsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	role, ok := permissions.BuiltinRoles[name]
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf(
+			"Unknown role %q. Expected one of: %s",
+			name, strings.Join(permissions.RoleNames(permissions.BuiltinRoles), ", "),
+		))
+		return
+	}
+
+	result, diags := RoleToTfModel(role)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
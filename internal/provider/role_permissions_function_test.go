@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/permissions"
+)
+
+func runRolePermissions(t *testing.T, name string) function.RunResponse {
+	t.Helper()
+
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.ObjectUnknown(RoleAttrTypes)),
+	}
+	NewRolePermissionsFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(name)}),
+	}, &resp)
+	return resp
+}
+
+func TestRolePermissionsFunction(t *testing.T) {
+	resp := runRolePermissions(t, "finance_viewer")
+	if resp.Error != nil {
+		t.Fatal(resp.Error)
+	}
+
+	expected, diags := RoleToTfModel(permissions.BuiltinRoles["finance_viewer"])
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !resp.Result.Value().Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, resp.Result.Value())
+	}
+}
+
+func TestRolePermissionsFunctionUnknownRole(t *testing.T) {
+	resp := runRolePermissions(t, "janitor")
+	if resp.Error == nil {
+		t.Fatal("expected an error for an unknown role")
+	}
+	if resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 0 {
+		t.Errorf("expected the error to point at the name argument, got %v", resp.Error.FunctionArgument)
+	}
+}
+
+func TestAccRolePermissionsFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::androidpublisher::role_permissions("support_agent").developer_account_permissions[0]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "CAN_REPLY_TO_REVIEWS_GLOBAL"),
+				),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::androidpublisher::role_permissions("janitor")
+}
+`,
+				ExpectError: regexp.MustCompile("Unknown role"),
+			},
+		},
+	})
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/fakeplay"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/names"
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+// TestMain runs the sweepers when go test is called with -sweep, for
+// example "go test ./internal/provider -sweep=all". Sweepers only clean the
+// live account, as the fake API starts empty on every run; the value of
+// -sweep is not used.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("androidpublisher_grant", &resource.Sweeper{
+		Name: "androidpublisher_grant",
+		F: func(region string) error {
+			return sweep(sweepGrants)
+		},
+	})
+	resource.AddTestSweepers("androidpublisher_user", &resource.Sweeper{
+		Name:         "androidpublisher_user",
+		Dependencies: []string{"androidpublisher_grant"},
+		F: func(region string) error {
+			return sweep(sweepUsers)
+		},
+	})
+}
+
+// sweep configures the provider under test and runs a sweeper on the test
+// developer account.
+func sweep(sweeper func(ctx context.Context, c *GoogleProviderContext, developerID string) error) error {
+	ctx := context.Background()
+	if !testAccLive() {
+		return fmt.Errorf("%s must be set to run sweepers against the live account", liveTestsEnvVar)
+	}
+	if env.TestDeveloperId == "" {
+		return errors.New("TEST_DEVELOPER_ID must be set to run sweepers")
+	}
+	providerContext, diags := configureProvider(ctx, testAccProvider())
+	if diags.HasError() {
+		return fmt.Errorf("unable to configure provider: %v", diags)
+	}
+	return sweeper(ctx, providerContext, env.TestDeveloperId)
+}
+
+// isSweepable reports whether the user was created by the acceptance tests,
+// which only ever create the TEST_EMAIL user. The identity the provider
+// authenticates as is never swept.
+func isSweepable(email string, callerEmail string) bool {
+	if email == "" || strings.EqualFold(email, callerEmail) {
+		return false
+	}
+	return strings.EqualFold(email, env.TestEmail)
+}
+
+// sweepableUsers lists the users of the developer account the acceptance
+// tests created.
+func sweepableUsers(ctx context.Context, c *GoogleProviderContext, developerID string) ([]*androidpublisher.User, error) {
+	var users []*androidpublisher.User
+	err := c.ForEachUser(ctx, developerID, func(user *androidpublisher.User) error {
+		if isSweepable(user.Email, c.CallerEmail) {
+			users = append(users, user)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list users: %w", err)
+	}
+	return users, nil
+}
+
+// sweepGrants deletes every grant of the users the acceptance tests created.
+func sweepGrants(ctx context.Context, c *GoogleProviderContext, developerID string) error {
+	users, err := sweepableUsers(ctx, c, developerID)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, user := range users {
+		for _, grant := range user.Grants {
+			log.Printf("[INFO] Deleting grant %s", grant.Name)
+			err := c.Mutate(ctx, developerID, func() error {
+				return c.Grants.Delete(ctx, grant.Name)
+			})
+			if err != nil {
+				errs = append(errs, fmt.Errorf("unable to delete grant %s: %w", grant.Name, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sweepUsers deletes the users the acceptance tests created.
+func sweepUsers(ctx context.Context, c *GoogleProviderContext, developerID string) error {
+	users, err := sweepableUsers(ctx, c, developerID)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, user := range users {
+		name := names.User{DeveloperID: developerID, Email: user.Email}.String()
+		log.Printf("[INFO] Deleting user %s", name)
+		err := c.Mutate(ctx, developerID, func() error {
+			return c.Users.Delete(ctx, name)
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to delete user %s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func TestSweepers(t *testing.T) {
+	ctx := context.Background()
+	const developerID = "123"
+
+	if !testAccLive() {
+		if err := sweep(sweepUsers); err == nil {
+			t.Error("expected sweepers to refuse to run without live tests")
+		}
+	}
+
+	play := fakeplay.New()
+	play.SeedAccount(developerID, fakeOwnerEmail)
+	play.AddApp(fakePackageName)
+	for _, email := range []string{env.TestEmail, "kept@example.com"} {
+		play.PutUser(developerID, &androidpublisher.User{
+			Email:  email,
+			Grants: []*androidpublisher.Grant{{PackageName: fakePackageName}},
+		})
+	}
+	server := httptest.NewServer(play)
+	t.Cleanup(server.Close)
+	providerContext := testConfigure(t, &GoogleProvider{clientOptions: fakeplay.ClientOptions(server.URL)})
+
+	if err := sweepGrants(ctx, providerContext, developerID); err != nil {
+		t.Fatal(err)
+	}
+	for _, user := range play.Users(developerID) {
+		if isSweepable(user.Email, providerContext.CallerEmail) && len(user.Grants) != 0 {
+			t.Errorf("expected the grants of %s to be swept, got %+v", user.Email, user.Grants)
+		}
+	}
+
+	if err := sweepUsers(ctx, providerContext, developerID); err != nil {
+		t.Fatal(err)
+	}
+	var remaining []string
+	for _, user := range play.Users(developerID) {
+		remaining = append(remaining, user.Email)
+		if user.Email == "kept@example.com" && len(user.Grants) != 1 {
+			t.Errorf("expected the grants of other users to be kept, got %+v", user.Grants)
+		}
+	}
+	if len(remaining) != 2 || remaining[0] != "kept@example.com" || remaining[1] != fakeOwnerEmail {
+		t.Errorf("expected only the owner and other users to remain, got %v", remaining)
+	}
+}
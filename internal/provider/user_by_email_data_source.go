@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UserByEmailDataSource{}
+
+func NewUserByEmailDataSource() datasource.DataSource {
+	return &UserByEmailDataSource{}
+}
+
+// UserByEmailDataSource defines the data source implementation.
+type UserByEmailDataSource struct {
+	*GoogleProviderContext
+}
+
+// UserByEmailDataModel describes the data source data model.
+type UserByEmailDataModel struct {
+	UserData
+	DeveloperID  types.String `tfsdk:"developer_id"`
+	AllowMissing types.Bool   `tfsdk:"allow_missing"`
+	Found        types.Bool   `tfsdk:"found"`
+}
+
+// SetFromUserData sets the found user, keeping the configured email.
+func (m *UserByEmailDataModel) SetFromUserData(userData UserData) {
+	email := m.Email
+	m.UserData = userData
+	m.Email = email
+	m.Found = types.BoolValue(true)
+}
+
+// SetMissing nulls every attribute of the user but the configured email.
+func (m *UserByEmailDataModel) SetMissing() {
+	m.Found = types.BoolValue(false)
+	m.AccessState = types.StringNull()
+	m.DeveloperAccountPermissions = types.ListNull(types.StringType)
+	m.ExpirationTime = types.StringNull()
+	m.Grants = types.ListNull(grantType)
+	m.Name = types.StringNull()
+	m.Partial = types.BoolNull()
+	m.PrincipalType = types.StringNull()
+}
+
+func (d *UserByEmailDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_by_email"
+}
+
+func (d *UserByEmailDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := userDataAttributes()
+	attributes["developer_id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the developer account",
+		Required:            true,
+	}
+	attributes["email"] = schema.StringAttribute{
+		MarkdownDescription: "The user's email address",
+		Required:            true,
+	}
+	attributes["allow_missing"] = schema.BoolAttribute{
+		MarkdownDescription: "If true, a missing user sets `found` to false instead of failing. Defaults to false.",
+		Optional:            true,
+	}
+	attributes["found"] = schema.BoolAttribute{
+		MarkdownDescription: "Whether the user exists in the developer account",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+
+		MarkdownDescription: "Retrieves a single user of a developer account by email address.",
+
+		Attributes: attributes,
+	}
+}
+
+func (d *UserByEmailDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	gCtx, ok := req.ProviderData.(*GoogleProviderContext)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GoogleProviderContext, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.GoogleProviderContext = gCtx
+}
+
+func (d *UserByEmailDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserByEmailDataModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := d.FindUser(ctx, data.DeveloperID.ValueString(), data.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list users", err.Error())
+		return
+	}
+
+	switch {
+	case user != nil:
+		userData, diags := UserToUserData(ctx, *user, d.PrincipalType(user.Email))
+		resp.Diagnostics.Append(diags...)
+		data.SetFromUserData(userData)
+	case data.AllowMissing.ValueBool():
+		data.SetMissing()
+	default:
+		resp.Diagnostics.AddError(
+			"Could not find user",
+			fmt.Sprintf("No user with email %q exists in developer account %q. Set allow_missing = true to return found = false instead.", data.Email.ValueString(), data.DeveloperID.ValueString()),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "read user by email data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+func TestUserByEmailDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	play, gCtx := newTestFakePlay(t)
+	play.PutUser("123", &androidpublisher.User{
+		Email:                       "a@example.com",
+		AccessState:                 AccessStateGranted,
+		DeveloperAccountPermissions: []string{"CAN_VIEW_APP_QUALITY_GLOBAL"},
+		Grants: []*androidpublisher.Grant{{
+			PackageName:         fakePackageName,
+			AppLevelPermissions: []string{"CAN_REPLY_TO_REVIEWS"},
+		}},
+	})
+	d := &UserByEmailDataSource{GoogleProviderContext: gCtx}
+
+	read := func(email string) UserByEmailDataModel {
+		t.Helper()
+		model := UserByEmailDataModel{
+			DeveloperID:  types.StringValue("123"),
+			AllowMissing: types.BoolValue(true),
+		}
+		model.SetMissing()
+		model.Email = types.StringValue(email)
+		model.Found = types.BoolNull()
+
+		config, state := testDataSourceConfig(t, d, &model)
+		resp := datasource.ReadResponse{State: state}
+		d.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatal(resp.Diagnostics)
+		}
+		var data UserByEmailDataModel
+		if diags := resp.State.Get(ctx, &data); diags.HasError() {
+			t.Fatal(diags)
+		}
+		return data
+	}
+
+	found := read("a@example.com")
+	if !found.Found.ValueBool() || found.AccessState.ValueString() != AccessStateGranted {
+		t.Errorf("expected the granted user to be found, got %+v", found)
+	}
+	if len(found.Grants.Elements()) != 1 || len(found.DeveloperAccountPermissions.Elements()) != 1 {
+		t.Errorf("expected the user's grant and permission, got %+v", found)
+	}
+	if !found.ExpirationTime.IsNull() {
+		t.Errorf("expected no expiration time, got %s", found.ExpirationTime)
+	}
+
+	missing := read("missing@example.com")
+	if missing.Found.ValueBool() || !missing.Name.IsNull() || !missing.Grants.IsNull() {
+		t.Errorf("expected a missing user, got %+v", missing)
+	}
+	if missing.Email.ValueString() != "missing@example.com" {
+		t.Errorf("expected the configured email to be kept, got %s", missing.Email)
+	}
+}
+
+func TestAccUserByEmailDataSource(t *testing.T) {
+	missingConfig := func(allowMissing bool) string {
+		return fmt.Sprintf(`
+data "androidpublisher_user_by_email" "test" {
+  developer_id  = %q
+  email         = "missing-user@example.com"
+  allow_missing = %t
+}
+`, env.TestDeveloperId, allowMissing)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccCassetteProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "androidpublisher_user" "test" {
+  email = %[1]q
+  developer_id = %[2]q
+  developer_account_permissions = [ "CAN_VIEW_APP_QUALITY_GLOBAL"]
+  deletion_protection = false
+}
+
+data "androidpublisher_user_by_email" "test" {
+  developer_id = %[2]q
+  email        = androidpublisher_user.test.email
+}
+`, env.TestEmail, env.TestDeveloperId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.androidpublisher_user_by_email.test", "found", "true"),
+					resource.TestCheckResourceAttr("data.androidpublisher_user_by_email.test", "email", env.TestEmail),
+					resource.TestCheckResourceAttr("data.androidpublisher_user_by_email.test", "developer_account_permissions.#", "1"),
+				),
+			},
+			{
+				Config: missingConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.androidpublisher_user_by_email.test", "found", "false"),
+					resource.TestCheckNoResourceAttr("data.androidpublisher_user_by_email.test", "name"),
+				),
+			},
+			{
+				Config:      missingConfig(false),
+				ExpectError: regexp.MustCompile("Could not find user"),
+			},
+		},
+	})
+}
@@ -6,12 +6,13 @@ package provider
 import (
 	"context"
 	"fmt"
-	"github.com/tbui17/terraform-provider-androidpublisher/internal/grant"
-	"github.com/tbui17/terraform-provider-androidpublisher/internal/lib"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/apimodel"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/names"
 	"google.golang.org/api/androidpublisher/v3"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -28,23 +29,26 @@ type UserDataSource struct {
 	*GoogleProviderContext
 }
 
+// UserData is a user of the developer account as listed by the data sources.
 type UserData struct {
-	AccessState                 types.String `tfsdk:"access_state"`
-	Email                       types.String `tfsdk:"email"`
-	ExpirationTime              types.String `tfsdk:"expiration_time"`
-	Grants                      types.List   `tfsdk:"grants"`
-	Name                        types.String `tfsdk:"name"`
-	DeveloperAccountPermissions types.List   `tfsdk:"developer_account_permissions"`
+	// UserModel holds the attributes of the API user.
+	apimodel.UserModel
+	PrincipalType types.String `tfsdk:"principal_type"`
 }
 
 // UserDataModel describes the resource data model.
 type UserDataModel struct {
-	DeveloperID types.String `tfsdk:"developer_id"`
-	Value       []UserData   `tfsdk:"value"`
+	DeveloperID            types.String `tfsdk:"developer_id"`
+	EmailRegex             types.String `tfsdk:"email_regex"`
+	AccessStates           types.List   `tfsdk:"access_states"`
+	HasDeveloperPermission types.String `tfsdk:"has_developer_permission"`
+	HasPackageGrant        types.String `tfsdk:"has_package_grant"`
+	ExpiringBefore         types.String `tfsdk:"expiring_before"`
+	Value                  []UserData   `tfsdk:"value"`
 }
 
 func (m *UserDataModel) GetDeveloperIdFragment() string {
-	return lib.DeveloperIDToParentFragment(m.DeveloperID.ValueString())
+	return names.Developer{ID: m.DeveloperID.ValueString()}.String()
 }
 
 func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -54,62 +58,39 @@ func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRe
 func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 
-		MarkdownDescription: "Retrieves a list of users associated with a developer account. Maps to the https://developers.google.com/android-publisher/api-ref/rest/v3/users/list endpoint.",
+		MarkdownDescription: "Retrieves a list of users associated with a developer account. Maps to the https://developers.google.com/android-publisher/api-ref/rest/v3/users/list endpoint. The optional filters are applied after listing every user and are combined with AND.",
 
 		Attributes: map[string]schema.Attribute{
 			"developer_id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the developer account",
 				Required:            true,
 			},
+			"email_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return users whose email matches this regular expression",
+				Optional:            true,
+			},
+			"access_states": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return users in one of these access states, e.g. `INVITED` or `ACCESS_GRANTED`",
+				Optional:            true,
+			},
+			"has_developer_permission": schema.StringAttribute{
+				MarkdownDescription: "Only return users holding this developer account permission",
+				Optional:            true,
+			},
+			"has_package_grant": schema.StringAttribute{
+				MarkdownDescription: "Only return users with a grant for this package name",
+				Optional:            true,
+			},
+			"expiring_before": schema.StringAttribute{
+				MarkdownDescription: "Only return users whose access expires before this RFC3339 timestamp",
+				Optional:            true,
+			},
 
 			"value": schema.ListNestedAttribute{
 				MarkdownDescription: "The list of users",
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"email": schema.StringAttribute{
-							MarkdownDescription: "The user's email address",
-							Computed:            true,
-						},
-						"developer_account_permissions": schema.ListAttribute{
-							ElementType:         types.StringType,
-							Computed:            true,
-							MarkdownDescription: "The list of permissions granted to the user",
-						},
-						"expiration_time": schema.StringAttribute{
-							MarkdownDescription: "The time at which the user's access expires",
-							Computed:            true,
-						},
-						"access_state": schema.StringAttribute{
-							MarkdownDescription: "The state of the user's access to the Play Console",
-							Computed:            true,
-						},
-						"name": schema.StringAttribute{
-							MarkdownDescription: "Resource name for this user, following the pattern \"developers/{developer}/ users/{email}\".",
-							Computed:            true,
-						},
-
-						"grants": schema.ListNestedAttribute{
-							MarkdownDescription: "The list of grants for the user",
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"name": schema.StringAttribute{
-										MarkdownDescription: "The name of the grant",
-										Computed:            true,
-									},
-									"package_name": schema.StringAttribute{
-										MarkdownDescription: "The package name of the app for which the user has access",
-										Computed:            true,
-									},
-									"app_level_permissions": schema.ListAttribute{
-										MarkdownDescription: "The list of app-level permissions granted to the user",
-										Computed:            true,
-										ElementType:         types.StringType,
-									},
-								},
-							},
-							Computed: true,
-						},
-					},
+					Attributes: userDataAttributes(),
 				},
 				Computed: true,
 			},
@@ -147,15 +128,24 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	request := d.AndroidPublisherService.Users.List(data.GetDeveloperIdFragment())
-	usersResponse, err := request.PageSize(-1).Do()
+	filter, diags := NewUserFilter(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := d.ListUsers(ctx, data.DeveloperID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to list users", err.Error())
 		return
 	}
-	var userDataEntries []UserData
-	for _, user := range usersResponse.Users {
-		userData := UserToUserData(*user)
+	userDataEntries := make([]UserData, 0)
+	for _, user := range users {
+		if !filter.Matches(user) {
+			continue
+		}
+		userData, diags := UserToUserData(ctx, *user, d.PrincipalType(user.Email))
+		resp.Diagnostics.Append(diags...)
 		userDataEntries = append(userDataEntries, userData)
 	}
 
@@ -169,13 +159,21 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func UserToUserData(user androidpublisher.User) UserData {
-	return UserData{
-		AccessState:                 types.StringValue(user.AccessState),
-		Email:                       types.StringValue(user.Email),
-		ExpirationTime:              types.StringValue(user.ExpirationTime),
-		Name:                        types.StringValue(user.Name),
-		DeveloperAccountPermissions: lib.StrListToTfModel(user.DeveloperAccountPermissions),
-		Grants:                      grant.GrantsToTfModel(user.Grants),
+// principalTypeDataDescription documents the inferred principal type of data source users.
+const principalTypeDataDescription = "The kind of identity: `user`, `group` or `service_account`. Service accounts are detected by address and groups are the provider's `group_emails`, as the API does not report the type."
+
+// userDataAttributes returns the schema attributes of UserData.
+func userDataAttributes() map[string]schema.Attribute {
+	attributes := apimodel.UserDataSourceAttributes()
+	attributes["principal_type"] = schema.StringAttribute{
+		MarkdownDescription: principalTypeDataDescription,
+		Computed:            true,
 	}
+	return attributes
+}
+
+func UserToUserData(ctx context.Context, user androidpublisher.User, principalType string) (UserData, diag.Diagnostics) {
+	data := UserData{PrincipalType: types.StringValue(principalType)}
+	diags := data.FromAPI(ctx, &user)
+	return data, diags
 }
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -55,6 +56,43 @@ func TestAccUserDataSourceWithJsonAuth(t *testing.T) {
 	})
 }
 
+func TestAccUserDataSourceWithFilters(t *testing.T) {
+	filteredConfig := fmt.Sprintf(`
+data "androidpublisher_user" "test" {
+  developer_id  = %q
+  email_regex   = %q
+  access_states = ["INVITED", "ACCESS_GRANTED"]
+}
+`, env.TestDeveloperId, "^"+regexp.QuoteMeta(env.TestEmail)+"$")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: filteredConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("data.androidpublisher_user.test", "value.#", func(value string) error {
+						if value != "0" && value != "1" {
+							return fmt.Errorf("expected at most one user, got %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+data "androidpublisher_user" "test" {
+  developer_id = %q
+  email_regex  = "("
+}
+`, env.TestDeveloperId),
+				ExpectError: regexp.MustCompile("Invalid email_regex"),
+			},
+		},
+	})
+}
+
 func testCheckResourceCountNotEmpty(inp string) error {
 
 	i, err := strconv.Atoi(inp)
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/lib"
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+// UserFilter selects users from a Users.List response. Zero-valued fields match every user.
+type UserFilter struct {
+	EmailRegex             *regexp.Regexp
+	AccessStates           []string
+	HasDeveloperPermission string
+	HasPackageGrant        string
+	ExpiringBefore         *time.Time
+}
+
+// NewUserFilter builds a UserFilter from the data source configuration.
+func NewUserFilter(ctx context.Context, data UserDataModel) (UserFilter, diag.Diagnostics) {
+	var filter UserFilter
+	var diags diag.Diagnostics
+
+	if !data.EmailRegex.IsNull() {
+		re, err := regexp.Compile(data.EmailRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("email_regex"), "Invalid email_regex", err.Error())
+		}
+		filter.EmailRegex = re
+	}
+
+	if !data.AccessStates.IsNull() {
+		accessStates, d := lib.TFListToList[string](ctx, data.AccessStates)
+		diags.Append(d...)
+		filter.AccessStates = accessStates
+	}
+
+	filter.HasDeveloperPermission = data.HasDeveloperPermission.ValueString()
+	filter.HasPackageGrant = data.HasPackageGrant.ValueString()
+
+	if !data.ExpiringBefore.IsNull() {
+		expiringBefore, err := time.Parse(time.RFC3339, data.ExpiringBefore.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("expiring_before"), "Invalid expiring_before", fmt.Sprintf("Expected an RFC3339 timestamp: %v", err))
+		}
+		filter.ExpiringBefore = &expiringBefore
+	}
+
+	return filter, diags
+}
+
+// Matches reports whether the user satisfies every configured filter.
+func (f UserFilter) Matches(user *androidpublisher.User) bool {
+	if f.EmailRegex != nil && !f.EmailRegex.MatchString(user.Email) {
+		return false
+	}
+	if f.AccessStates != nil && !slices.Contains(f.AccessStates, user.AccessState) {
+		return false
+	}
+	if f.HasDeveloperPermission != "" && !slices.Contains(user.DeveloperAccountPermissions, f.HasDeveloperPermission) {
+		return false
+	}
+	if f.HasPackageGrant != "" && findGrant(user.Grants, f.HasPackageGrant) == nil {
+		return false
+	}
+	if f.ExpiringBefore != nil {
+		if user.ExpirationTime == "" {
+			return false
+		}
+		expiration, err := time.Parse(time.RFC3339, user.ExpirationTime)
+		if err != nil || !expiration.Before(*f.ExpiringBefore) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+func TestUserFilterMatches(t *testing.T) {
+	user := &androidpublisher.User{
+		Email:                       "release-bot@example.com",
+		AccessState:                 "ACCESS_GRANTED",
+		DeveloperAccountPermissions: []string{"CAN_VIEW_APP_QUALITY_GLOBAL"},
+		ExpirationTime:              "2030-01-01T00:00:00Z",
+		Grants: []*androidpublisher.Grant{
+			{PackageName: "com.example.app", AppLevelPermissions: []string{"CAN_REPLY_TO_REVIEWS"}},
+		},
+	}
+	before := func(s string) *time.Time {
+		ts, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &ts
+	}
+
+	tests := map[string]struct {
+		filter UserFilter
+		want   bool
+	}{
+		"empty filter":                 {UserFilter{}, true},
+		"email regex match":            {UserFilter{EmailRegex: regexp.MustCompile(`^release-.*@example\.com$`)}, true},
+		"email regex mismatch":         {UserFilter{EmailRegex: regexp.MustCompile(`@other\.com$`)}, false},
+		"access state match":           {UserFilter{AccessStates: []string{"INVITED", "ACCESS_GRANTED"}}, true},
+		"access state mismatch":        {UserFilter{AccessStates: []string{"INVITED"}}, false},
+		"developer permission match":   {UserFilter{HasDeveloperPermission: "CAN_VIEW_APP_QUALITY_GLOBAL"}, true},
+		"developer permission missing": {UserFilter{HasDeveloperPermission: "CAN_MANAGE_PERMISSIONS_GLOBAL"}, false},
+		"package grant match":          {UserFilter{HasPackageGrant: "com.example.app"}, true},
+		"package grant missing":        {UserFilter{HasPackageGrant: "com.example.other"}, false},
+		"expiring before match":        {UserFilter{ExpiringBefore: before("2031-01-01T00:00:00Z")}, true},
+		"expiring after":               {UserFilter{ExpiringBefore: before("2029-01-01T00:00:00Z")}, false},
+		"combined filters": {UserFilter{
+			AccessStates:    []string{"ACCESS_GRANTED"},
+			HasPackageGrant: "com.example.other",
+		}, false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tt.filter.Matches(user); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("expiring before ignores users without expiration", func(t *testing.T) {
+		noExpiry := *user
+		noExpiry.ExpirationTime = ""
+		if (UserFilter{ExpiringBefore: before("2031-01-01T00:00:00Z")}).Matches(&noExpiry) {
+			t.Error("expected user without expiration not to match")
+		}
+	})
+}
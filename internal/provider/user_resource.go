@@ -6,32 +6,59 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/tbui17/terraform-provider-androidpublisher/internal/grant"
 	"github.com/tbui17/terraform-provider-androidpublisher/internal/lib"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/mask"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/names"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/timetypes"
 
 	"google.golang.org/api/androidpublisher/v3"
 )
 
-func (m *UserResourceModel) SetFromUser(ctx context.Context, user androidpublisher.User) {
+func (m *UserResourceModel) SetFromUser(ctx context.Context, user androidpublisher.User) diag.Diagnostics {
 	m.AccessState = types.StringValue(user.AccessState)
 	m.Name = types.StringValue(user.Name)
 	m.Email = types.StringValue(user.Email)
+	if user.ExpirationTime == "" {
+		m.ExpirationTime = timetypes.NewRFC3339Null()
+	} else {
+		m.ExpirationTime = timetypes.NewRFC3339Value(user.ExpirationTime)
+	}
+
+	grants, diags := grantsToList(ctx, user.Grants)
+	m.Grants = grants
+	return diags
+}
 
-	m.Grants = grant.GrantsToTfModel(user.Grants)
+// SetDefaultPrincipalType sets the inferred principal type when none is planned or stored.
+func (m *UserResourceModel) SetDefaultPrincipalType(principalType func(email string) string) {
+	if m.PrincipalType.IsNull() || m.PrincipalType.IsUnknown() {
+		m.PrincipalType = types.StringValue(principalType(m.Email.ValueString()))
+	}
 }
 
 func (m *UserResourceModel) GetParent() string {
-	return "developers/" + m.DeveloperID.ValueString()
+	return names.Developer{ID: m.DeveloperID.ValueString()}.String()
 }
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithImportState = &UserResource{}
+var _ resource.ResourceWithModifyPlan = &UserResource{}
+var _ resource.ResourceWithValidateConfig = &UserResource{}
 
 // UserResource defines the resource implementation.
 type UserResource struct {
@@ -44,13 +71,20 @@ func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataReques
 
 // UserResourceModel describes the resource data model.
 type UserResourceModel struct {
-	AccessState                 types.String `tfsdk:"access_state"`
-	DeveloperID                 types.String `tfsdk:"developer_id"`
-	Email                       types.String `tfsdk:"email"`
-	ExpirationTime              types.String `tfsdk:"expiration_time"`
-	Grants                      types.List   `tfsdk:"grants"`
-	Name                        types.String `tfsdk:"name"`
-	DeveloperAccountPermissions types.List   `tfsdk:"developer_account_permissions"`
+	AccessState                 types.String      `tfsdk:"access_state"`
+	DeveloperID                 types.String      `tfsdk:"developer_id"`
+	Email                       types.String      `tfsdk:"email"`
+	ExpirationTime              timetypes.RFC3339 `tfsdk:"expiration_time"`
+	ExpiresIn                   types.String      `tfsdk:"expires_in"`
+	Grants                      types.List        `tfsdk:"grants"`
+	Name                        types.String      `tfsdk:"name"`
+	DeveloperAccountPermissions types.List        `tfsdk:"developer_account_permissions"`
+	ReinviteOnExpiry            types.Bool        `tfsdk:"reinvite_on_expiry"`
+	WaitForAcceptance           types.Bool        `tfsdk:"wait_for_acceptance"`
+	WaitForAcceptanceTimeout    types.String      `tfsdk:"wait_for_acceptance_timeout"`
+	AllowAdminRemoval           types.Bool        `tfsdk:"allow_admin_removal"`
+	DeletionProtection          types.Bool        `tfsdk:"deletion_protection"`
+	PrincipalType               types.String      `tfsdk:"principal_type"`
 }
 
 func NewUserResource() resource.Resource {
@@ -76,19 +110,58 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"principal_type": schema.StringAttribute{
+				MarkdownDescription: "The kind of identity the email belongs to: `user`, `group` or `service_account`. The email is validated for the type. When unset, it is inferred: service account addresses are detected, addresses in the provider's `group_emails` are groups, and anything else is a user.",
+				Optional:            true,
+				Computed:            true,
+			},
 			"developer_account_permissions": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Required:            true,
 				MarkdownDescription: "The list of permissions granted to the user",
 			},
 			"expiration_time": schema.StringAttribute{
-				MarkdownDescription: "The time at which the user's access expires",
+				MarkdownDescription: "The time at which the user's access expires, as an RFC3339 timestamp such as `2030-01-02T15:04:05Z`. Computed from `expires_in` when that is set instead.",
+				CustomType:          timetypes.RFC3339Type{},
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					expirationTimePlanModifier{},
+				},
+			},
+			"expires_in": schema.StringAttribute{
+				MarkdownDescription: "How long the user's access lasts, as a Go duration such as `720h`. The expiration time is computed when the user is created, or when this value changes. Conflicts with `expiration_time`.",
 				Optional:            true,
 			},
 			"access_state": schema.StringAttribute{
 				MarkdownDescription: "The state of the user's access to the Play Console",
 				Computed:            true,
 			},
+			"reinvite_on_expiry": schema.BoolAttribute{
+				MarkdownDescription: "Whether to replace the user, sending a fresh invitation, when the invitation has expired. The replacement is refused while `deletion_protection` is true. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"wait_for_acceptance": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait after creating the user until the invitation is accepted. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"wait_for_acceptance_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the invitation to be accepted when `wait_for_acceptance` is set, as a Go duration such as `30m` or `24h`. Defaults to `30m`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("30m"),
+			},
+			"allow_admin_removal": schema.BoolAttribute{
+				MarkdownDescription: "Whether to allow changes that remove the last user with `CAN_MANAGE_PERMISSIONS_GLOBAL`, or that remove or downgrade the identity the provider authenticates as. Must be applied before destroying such a user. The check is repeated when the change is applied, so removing several admins in one plan keeps the last one. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"deletion_protection": deletionProtectionAttribute(),
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Resource name for this user, following the pattern \"developers/{developer}/ users/{email}\".",
 				Computed:            true,
@@ -154,28 +227,299 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	expirationTime, err := ResolveExpirationTime(data.ExpirationTime, data.ExpiresIn)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("expires_in"), "Invalid expires_in", err.Error())
+		return
+	}
+	data.ExpirationTime = expirationTime
+
 	user := &androidpublisher.User{
 		Email:                       data.Email.ValueString(),
 		DeveloperAccountPermissions: permissions,
-		Name:                        lib.GetName(data.Email.ValueString(), data.DeveloperID.ValueString()),
+		Name:                        names.User{DeveloperID: data.DeveloperID.ValueString(), Email: data.Email.ValueString()}.String(),
 		ExpirationTime:              data.ExpirationTime.ValueString(),
 	}
 
 	parent := data.GetParent()
 
-	request := r.AndroidPublisherService.Users.Create(parent, user)
-
-	usr, err := request.Do()
+	var usr *androidpublisher.User
+	err = r.Mutate(ctx, data.DeveloperID.ValueString(), func() (err error) {
+		usr, err = r.Users.Create(ctx, parent, user)
+		return err
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating user", fmt.Sprintf("Unable to create user: %v", err))
 		return
 	}
 
-	data.SetFromUser(ctx, *usr)
+	resp.Diagnostics.Append(data.SetFromUser(ctx, *usr)...)
+	data.SetDefaultPrincipalType(r.PrincipalType)
 
 	tflog.Trace(ctx, "created a user resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
+	if data.WaitForAcceptance.ValueBool() {
+		resp.Diagnostics.Append(r.waitForAcceptance(ctx, &data)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+// waitForAcceptance polls until the invitation is accepted and refreshes data
+// with the accepted user. The user is already saved in state, so a failure
+// taints the resource rather than orphaning it.
+func (r *UserResource) waitForAcceptance(ctx context.Context, data *UserResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	timeout, err := time.ParseDuration(data.WaitForAcceptanceTimeout.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("wait_for_acceptance_timeout"), "Invalid wait_for_acceptance_timeout", err.Error())
+		return diags
+	}
+
+	user, err := r.WaitForAcceptance(ctx, data.DeveloperID.ValueString(), data.Email.ValueString(), timeout)
+	if user != nil {
+		diags.Append(data.SetFromUser(ctx, *user)...)
+	}
+	if err != nil {
+		diags.AddError("Error waiting for invitation acceptance", err.Error())
+	}
+	return diags
+}
+
+func (r *UserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.DeveloperID.IsUnknown() {
+		if err := names.ValidateDeveloperID(data.DeveloperID.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("developer_id"), "Invalid developer ID", err.Error())
+		}
+	}
+
+	if !data.Email.IsUnknown() && !data.PrincipalType.IsUnknown() {
+		if err := ValidatePrincipal(data.PrincipalType.ValueString(), data.Email.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("email"), "Invalid email", err.Error())
+		}
+	}
+
+	// The timeout is only used after the user is created, so a typo must
+	// fail the plan rather than taint a user that was already invited.
+	if !data.WaitForAcceptanceTimeout.IsNull() && !data.WaitForAcceptanceTimeout.IsUnknown() {
+		timeout, err := time.ParseDuration(data.WaitForAcceptanceTimeout.ValueString())
+		if err != nil || timeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("wait_for_acceptance_timeout"),
+				"Invalid wait_for_acceptance_timeout",
+				fmt.Sprintf("Expected a positive Go duration such as \"30m\", got %q.", data.WaitForAcceptanceTimeout.ValueString()),
+			)
+		}
+	}
+
+	if !data.ExpiresIn.IsNull() && !data.ExpirationTime.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expires_in"),
+			"Conflicting expiration",
+			"Only one of expiration_time and expires_in can be set.",
+		)
+	}
+
+	if data.ExpiresIn.IsNull() || data.ExpiresIn.IsUnknown() {
+		return
+	}
+	duration, err := time.ParseDuration(data.ExpiresIn.ValueString())
+	if err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expires_in"),
+			"Invalid expires_in",
+			fmt.Sprintf("Expected a positive Go duration such as \"720h\", got %q.", data.ExpiresIn.ValueString()),
+		)
+	}
+}
+
+func (r *UserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(r.planPrincipalType(ctx, resp)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if req.State.Raw.IsNull() {
+		if !req.Plan.Raw.IsNull() {
+			resp.Diagnostics.Append(r.checkEscalations(ctx, req.Plan, nil)...)
+		}
+		return
+	}
+
+	var plan, state UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statePermissions, diags := lib.TFListToList[string](ctx, state.DeveloperAccountPermissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "destroy", fmt.Sprintf("user %q", state.Email.ValueString()))...)
+		loss := AccessLoss{Email: state.Email.ValueString(), Removed: statePermissions, Deleted: true}
+		resp.Diagnostics.Append(r.guardAccessLoss(ctx, state.DeveloperID.ValueString(), loss, state.AllowAdminRemoval.ValueBool())...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reinvite := state.AccessState.ValueString() == AccessStateInvitationExpired && plan.ReinviteOnExpiry.ValueBool()
+	if reinvite {
+		resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "replace", fmt.Sprintf("user %q to resend its expired invitation", state.Email.ValueString()))...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.AccessState = types.StringUnknown()
+		plan.Name = types.StringUnknown()
+		plan.Grants = types.ListUnknown(grantType)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("access_state"))
+		resp.Diagnostics.AddWarning(
+			"Invitation expired",
+			fmt.Sprintf("The invitation for %q has expired. The user will be replaced to send a new invitation.", plan.Email.ValueString()),
+		)
+	}
+
+	if plan.DeveloperAccountPermissions.IsUnknown() {
+		return
+	}
+	planPermissions, diags := lib.TFListToList[string](ctx, plan.DeveloperAccountPermissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	loss := AccessLoss{Email: state.Email.ValueString()}
+	replaced := !plan.Email.Equal(state.Email) || !plan.DeveloperID.Equal(state.DeveloperID)
+	if replaced {
+		resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "replace", fmt.Sprintf("user %q", state.Email.ValueString()))...)
+	}
+	if replaced || reinvite {
+		// The new user starts without permissions, so every planned
+		// permission is granted again.
+		loss.Removed = statePermissions
+		loss.Deleted = true
+		resp.Diagnostics.Append(r.checkEscalations(ctx, resp.Plan, nil)...)
+	} else {
+		change := DiffPermissions(plan.Email.ValueString(), developerAccountScope, statePermissions, planPermissions)
+		loss.Removed = change.Removed
+		resp.Diagnostics.Append(r.CheckEscalations([]PermissionChange{change})...)
+	}
+	resp.Diagnostics.Append(r.guardAccessLoss(ctx, state.DeveloperID.ValueString(), loss, plan.AllowAdminRemoval.ValueBool())...)
+}
+
+// planPrincipalType infers principal_type from the email when it is not configured.
+func (r *UserResource) planPrincipalType(ctx context.Context, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var principalType, email types.String
+	diags := resp.Plan.GetAttribute(ctx, path.Root("principal_type"), &principalType)
+	diags.Append(resp.Plan.GetAttribute(ctx, path.Root("email"), &email)...)
+	if diags.HasError() || !principalType.IsUnknown() || email.IsUnknown() {
+		return diags
+	}
+
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("principal_type"), r.PrincipalType(email.ValueString()))...)
+	return diags
+}
+
+// checkEscalations reports the sensitive permissions the plan adds to a user
+// currently holding the given permissions.
+func (r *UserResource) checkEscalations(ctx context.Context, plan tfsdk.Plan, current []string) diag.Diagnostics {
+	var data UserResourceModel
+	diags := plan.Get(ctx, &data)
+	if diags.HasError() || data.DeveloperAccountPermissions.IsUnknown() {
+		return diags
+	}
+
+	planned, listDiags := lib.TFListToList[string](ctx, data.DeveloperAccountPermissions)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	change := DiffPermissions(data.Email.ValueString(), developerAccountScope, current, planned)
+	diags.Append(r.CheckEscalations([]PermissionChange{change})...)
+	return diags
+}
+
+// guardAccessLoss returns an error if the loss would lock the provider or
+// everyone else out of the developer account, unless allowed.
+func (r *UserResource) guardAccessLoss(ctx context.Context, developerID string, loss AccessLoss, allow bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if allow || loss.IsEmpty() || r.GoogleProviderContext == nil {
+		return diags
+	}
+
+	var users []*androidpublisher.User
+	if loss.RemovesAdmin() {
+		var err error
+		users, err = r.ListUsers(ctx, developerID)
+		if err != nil {
+			diags.AddError("Error reading users", fmt.Sprintf("Unable to check for remaining admins: %v", err))
+			return diags
+		}
+	}
+
+	diags.Append(r.lockoutDiagnostics(users, loss)...)
+	return diags
+}
+
+func (r *UserResource) lockoutDiagnostics(users []*androidpublisher.User, loss AccessLoss) diag.Diagnostics {
+	var diags diag.Diagnostics
+	reasons := LockoutReasons(users, r.CallerEmail, loss)
+	if len(reasons) > 0 {
+		diags.AddError(
+			"Change would lock out the developer account",
+			strings.Join(reasons, "\n")+"\n\nSet allow_admin_removal = true to proceed. When destroying the user, apply that setting first.",
+		)
+	}
+	return diags
+}
+
+// mutateGuarded runs fn through Mutate after repeating guardAccessLoss
+// against a fresh listing. Each user is checked on its own at plan time, so a
+// plan removing two admins passes both checks; the changes that remove an
+// admin are serialized here so that the second one sees the first.
+func (r *UserResource) mutateGuarded(ctx context.Context, developerID string, loss AccessLoss, allow bool, fn func() error) (diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+	if allow || !loss.RemovesAdmin() {
+		return diags, r.Mutate(ctx, developerID, fn)
+	}
+
+	release, err := r.adminRemovals.acquire(ctx, developerID)
+	if err != nil {
+		return diags, err
+	}
+	defer release()
+
+	err = r.Mutate(ctx, developerID, func() error {
+		users, err := r.loadUsers(ctx, developerID)
+		if err != nil {
+			diags.AddError("Error reading users", fmt.Sprintf("Unable to check for remaining admins: %v", err))
+			return nil
+		}
+		diags.Append(r.lockoutDiagnostics(users, loss)...)
+		if diags.HasError() {
+			return nil
+		}
+		return fn()
+	})
+	return diags, err
 }
 
 func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -185,7 +529,7 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	result, err := r.GetUser(data)
+	result, err := r.GetUser(ctx, data)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading user", fmt.Sprintf("Unable to read user: %v", err))
 		return
@@ -195,36 +539,31 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		resp.Diagnostics.AddError("Could not find user", fmt.Sprintf("Could not find user with provided params %v", data))
 		return
 	}
-	data.SetFromUser(ctx, *result)
+	resp.Diagnostics.Append(data.SetFromUser(ctx, *result)...)
+	data.SetDefaultPrincipalType(r.PrincipalType)
+	if data.DeveloperAccountPermissions.IsNull() {
+		// Only an imported user has no permissions in state yet.
+		data.DeveloperAccountPermissions = lib.StrListToTfModel(result.DeveloperAccountPermissions)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *UserResource) GetUser(data UserResourceModel) (*androidpublisher.User, error) {
-	request := r.AndroidPublisherService.Users.List(data.GetParent()).PageSize(-1)
-
-	response, err := request.Do()
-	if err != nil {
-
-		return nil, err
-	}
-
-	var result *androidpublisher.User
+func (r *UserResource) GetUser(ctx context.Context, data UserResourceModel) (*androidpublisher.User, error) {
+	return r.FindUser(ctx, data.DeveloperID.ValueString(), data.Email.ValueString())
+}
 
-	emailStr := data.Email.ValueString()
-	for _, user := range response.Users {
-		if user.Email == emailStr {
-			result = user
-			break
-		}
-	}
-	return result, nil
+// userUpdateFields maps the attributes of a user that can be patched to their API fields.
+var userUpdateFields = mask.Fields{
+	"developer_account_permissions": "developerAccountPermissions",
+	"expiration_time":               "expirationTime",
 }
 
 func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data UserResourceModel
+	var data, state UserResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -234,24 +573,68 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-
-	user := &androidpublisher.User{
-		DeveloperAccountPermissions: permissions,
-		ExpirationTime:              data.ExpirationTime.ValueString(),
+	statePermissions, diags := lib.TFListToList[string](ctx, state.DeveloperAccountPermissions)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
 	}
 
-	userName := lib.GetName(data.Email.ValueString(), data.DeveloperID.ValueString())
-	updateFields := "developerAccountPermissions,expirationTime"
-	request := r.AndroidPublisherService.Users.Patch(userName, user).UpdateMask(updateFields)
-	usr, err := request.Do()
+	expirationTime, err := ResolveExpirationTime(data.ExpirationTime, data.ExpiresIn)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating user", fmt.Sprintf("Unable to update user: %v", err))
+		resp.Diagnostics.AddAttributeError(path.Root("expires_in"), "Invalid expires_in", err.Error())
 		return
 	}
+	data.ExpirationTime = expirationTime
 
-	data.SetFromUser(ctx, *usr)
+	updateMask, diags := mask.Diff(ctx, &data, &state, userUpdateFields)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	tflog.Trace(ctx, "created a user resource")
+	var usr *androidpublisher.User
+	if len(updateMask) == 0 {
+		usr, err = r.GetUser(ctx, data)
+		if err == nil && usr == nil {
+			err = fmt.Errorf("user %q no longer exists", data.Email.ValueString())
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading user", fmt.Sprintf("Unable to read user: %v", err))
+			return
+		}
+	} else {
+		user := &androidpublisher.User{
+			DeveloperAccountPermissions: permissions,
+			ExpirationTime:              data.ExpirationTime.ValueString(),
+		}
+		if updateMask.Contains("expirationTime") && data.ExpirationTime.IsNull() {
+			// Send an explicit null so the API removes the expiration.
+			user.NullFields = append(user.NullFields, "ExpirationTime")
+		}
+
+		loss := AccessLoss{
+			Email:   data.Email.ValueString(),
+			Removed: DiffPermissions(data.Email.ValueString(), developerAccountScope, statePermissions, permissions).Removed,
+		}
+		userName := names.User{DeveloperID: data.DeveloperID.ValueString(), Email: data.Email.ValueString()}.String()
+		diags, err = r.mutateGuarded(ctx, data.DeveloperID.ValueString(), loss, data.AllowAdminRemoval.ValueBool(), func() (err error) {
+			usr, err = r.Users.Patch(ctx, userName, user, updateMask.String())
+			return err
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating user", fmt.Sprintf("Unable to update user: %v", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(data.SetFromUser(ctx, *usr)...)
+	data.SetDefaultPrincipalType(r.PrincipalType)
+
+	tflog.Trace(ctx, "updated a user resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -262,10 +645,46 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	err := r.AndroidPublisherService.Users.Delete(data.Name.ValueString()).Do()
+	resp.Diagnostics.Append(checkDeletionProtection(data.DeletionProtection, "destroy", fmt.Sprintf("user %q", data.Email.ValueString()))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, diags := lib.TFListToList[string](ctx, data.DeveloperAccountPermissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	loss := AccessLoss{Email: data.Email.ValueString(), Removed: permissions, Deleted: true}
+	diags, err := r.mutateGuarded(ctx, data.DeveloperID.ValueString(), loss, data.AllowAdminRemoval.ValueBool(), func() error {
+		return r.Users.Delete(ctx, data.Name.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting user", fmt.Sprintf("Unable to delete user: %v", err))
 		return
 	}
 
 }
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name, err := names.ParseUser(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("developer_id"), name.DeveloperID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), name.Email)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	// Attributes the API does not return start from their defaults.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reinvite_on_expiry"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_acceptance"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_acceptance_timeout"), "30m")...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_admin_removal"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), true)...)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/lib"
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+func TestUserResourceImportState(t *testing.T) {
+	ctx := context.Background()
+	const userName = "developers/123/users/user@example.com"
+	play, gCtx := newTestFakePlay(t)
+	play.PutUser("123", &androidpublisher.User{
+		Email:                       "user@example.com",
+		AccessState:                 AccessStateGranted,
+		DeveloperAccountPermissions: []string{"CAN_VIEW_APP_QUALITY_GLOBAL"},
+	})
+	r := &UserResource{GoogleProviderContext: gCtx}
+
+	importState := func(id string) resource.ImportStateResponse {
+		resp := resource.ImportStateResponse{State: testResourceState(t, r, nil)}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: id}, &resp)
+		return resp
+	}
+
+	for _, id := range []string{"123/user@example.com", "developers/abc/users/user@example.com", "developers/123/users/"} {
+		if resp := importState(id); !resp.Diagnostics.HasError() {
+			t.Errorf("expected import ID %q to be rejected", id)
+		}
+	}
+
+	resp := importState(userName)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	readResp := resource.ReadResponse{State: resp.State}
+	r.Read(ctx, resource.ReadRequest{State: resp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatal(readResp.Diagnostics)
+	}
+
+	var data UserResourceModel
+	if diags := readResp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+	permissions, diags := lib.TFListToList[string](ctx, data.DeveloperAccountPermissions)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if data.DeveloperID.ValueString() != "123" || data.Email.ValueString() != "user@example.com" || data.AccessState.ValueString() != AccessStateGranted {
+		t.Errorf("expected the user to be read after import, got %+v", data)
+	}
+	if !slices.Equal(permissions, []string{"CAN_VIEW_APP_QUALITY_GLOBAL"}) {
+		t.Errorf("expected the permissions to be read after import, got %v", permissions)
+	}
+	if !data.DeletionProtection.ValueBool() || data.WaitForAcceptanceTimeout.ValueString() != "30m" {
+		t.Errorf("expected the attributes the API does not return to be defaulted, got %+v", data)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/permissions"
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+const testUserName = "developers/123/users/invitee@example.com"
+
+func setAcceptancePollInterval(t *testing.T, interval time.Duration) {
+	previous := acceptancePollInterval
+	acceptancePollInterval = interval
+	t.Cleanup(func() { acceptancePollInterval = previous })
+}
+
+func TestWaitForAcceptance(t *testing.T) {
+	setAcceptancePollInterval(t, time.Millisecond)
+
+	t.Run("accepted", func(t *testing.T) {
+		fake, gCtx := newTestFakePlay(t)
+		fake.PutUser("123", &androidpublisher.User{Email: "invitee@example.com", AccessState: AccessStateInvited})
+		polls := 0
+		fake.OnList = func(user *androidpublisher.User) {
+			polls++
+			if polls == 3 {
+				user.AccessState = AccessStateGranted
+			}
+		}
+
+		user, err := gCtx.WaitForAcceptance(context.Background(), "123", "invitee@example.com", time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if user.AccessState != AccessStateGranted || polls != 3 {
+			t.Errorf("expected access to be granted on the third poll, got %s after %d polls", user.AccessState, polls)
+		}
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		fake, gCtx := newTestFakePlay(t)
+		fake.PutUser("123", &androidpublisher.User{Email: "invitee@example.com", AccessState: AccessStateInvited})
+		fake.OnList = func(user *androidpublisher.User) { user.AccessState = AccessStateInvitationExpired }
+
+		_, err := gCtx.WaitForAcceptance(context.Background(), "123", "invitee@example.com", time.Minute)
+		if err == nil || !strings.Contains(err.Error(), "expired") {
+			t.Errorf("expected an expired invitation error, got %v", err)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		fake, gCtx := newTestFakePlay(t)
+		fake.PutUser("123", &androidpublisher.User{Email: "invitee@example.com", AccessState: AccessStateInvited})
+
+		user, err := gCtx.WaitForAcceptance(context.Background(), "123", "invitee@example.com", 20*time.Millisecond)
+		if err == nil || !strings.Contains(err.Error(), "timed out") {
+			t.Errorf("expected a timeout error, got %v", err)
+		}
+		if user == nil || user.AccessState != AccessStateInvited {
+			t.Errorf("expected the last seen user to be returned, got %+v", user)
+		}
+	})
+}
+
+func TestUserResourceValidatesWaitForAcceptanceTimeout(t *testing.T) {
+	ctx := context.Background()
+	r := &UserResource{}
+
+	tests := map[string]struct {
+		timeout     types.String
+		expectError bool
+	}{
+		"valid":    {types.StringValue("45m"), false},
+		"unset":    {types.StringNull(), false},
+		"unknown":  {types.StringUnknown(), false},
+		"typo":     {types.StringValue("45 minutes"), true},
+		"negative": {types.StringValue("-1h"), true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := testResourceConfig(t, r, testUserModel(func(m *UserResourceModel) {
+				m.WaitForAcceptance = types.BoolValue(true)
+				m.WaitForAcceptanceTimeout = tt.timeout
+			}))
+
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Errorf("expected error %t, got %v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestUserResourceReinviteOnExpiry(t *testing.T) {
+	ctx := context.Background()
+	fake, gCtx := newTestFakePlay(t)
+	fake.PutUser("123", &androidpublisher.User{Email: "invitee@example.com", AccessState: AccessStateInvited})
+	r := &UserResource{GoogleProviderContext: gCtx}
+
+	state := testUserState(t, r, func(m *UserResourceModel) {
+		m.AccessState = types.StringValue(AccessStateInvited)
+		m.ReinviteOnExpiry = types.BoolValue(true)
+	})
+
+	// plan refreshes the state and plans it with the configured permissions,
+	// which default to the permissions in state.
+	plan := func(state tfsdk.State, configured ...string) resource.ModifyPlanResponse {
+		readResp := resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, &readResp)
+		if readResp.Diagnostics.HasError() {
+			t.Fatal(readResp.Diagnostics)
+		}
+
+		planned := tfsdk.Plan{Schema: readResp.State.Schema, Raw: readResp.State.Raw.Copy()}
+		if configured != nil {
+			if diags := planned.SetAttribute(ctx, path.Root("developer_account_permissions"), configured); diags.HasError() {
+				t.Fatal(diags)
+			}
+		}
+		planResp := resource.ModifyPlanResponse{Plan: planned}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: readResp.State, Plan: planned}, &planResp)
+		return planResp
+	}
+
+	if resp := plan(state); resp.Diagnostics.HasError() || len(resp.RequiresReplace) != 0 {
+		t.Fatalf("expected no replacement while the invitation is pending, got %v %v", resp.RequiresReplace, resp.Diagnostics)
+	}
+
+	fake.OnList = func(user *androidpublisher.User) { user.AccessState = AccessStateInvitationExpired }
+	r.InvalidateUsers("123")
+
+	protected := tfsdk.State{Schema: state.Schema, Raw: state.Raw.Copy()}
+	if diags := protected.SetAttribute(ctx, path.Root("deletion_protection"), true); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if resp := plan(protected); !resp.Diagnostics.HasError() {
+		t.Errorf("expected deletion protection to refuse the replacement, got %v", resp.RequiresReplace)
+	}
+
+	resp := plan(state)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	if !resp.RequiresReplace.Contains(path.Root("access_state")) {
+		t.Fatalf("expected an expired invitation to require replacement, got %v", resp.RequiresReplace)
+	}
+	var accessState types.String
+	resp.Plan.GetAttribute(ctx, path.Root("access_state"), &accessState)
+	if !accessState.IsUnknown() {
+		t.Errorf("expected planned access_state to be unknown, got %s", accessState)
+	}
+
+	resp = plan(state, "CAN_VIEW_APP_QUALITY_GLOBAL", permissions.ManagePermissionsGlobal)
+	if !resp.RequiresReplace.Contains(path.Root("access_state")) {
+		t.Fatalf("expected an expired invitation to require replacement, got %v", resp.RequiresReplace)
+	}
+	var escalation bool
+	for _, d := range resp.Diagnostics.Warnings() {
+		escalation = escalation || (d.Summary() == "Privilege escalation" && strings.Contains(d.Detail(), permissions.ManagePermissionsGlobal))
+	}
+	if !escalation {
+		t.Errorf("expected the reinvitation to warn about %s, got %v", permissions.ManagePermissionsGlobal, resp.Diagnostics)
+	}
+}
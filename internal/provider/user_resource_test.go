@@ -17,6 +17,7 @@ resource "androidpublisher_user" "test" {
   email = %q
   developer_id = %q
   developer_account_permissions = [ "CAN_VIEW_APP_QUALITY_GLOBAL"]
+  deletion_protection = false
 }
 `, env.TestEmail, env.TestDeveloperId)
 
@@ -25,12 +26,23 @@ resource "androidpublisher_user" "test" {
 	 email = %q
 	 developer_id = %q
 	 developer_account_permissions = [ "CAN_VIEW_APP_QUALITY_GLOBAL","CAN_VIEW_NON_FINANCIAL_DATA_GLOBAL"]
+	 deletion_protection = false
+	}
+	`, env.TestEmail, env.TestDeveloperId)
+
+	expiringConfig := fmt.Sprintf(`
+	resource "androidpublisher_user" "test" {
+	 email = %q
+	 developer_id = %q
+	 developer_account_permissions = [ "CAN_VIEW_APP_QUALITY_GLOBAL","CAN_VIEW_NON_FINANCIAL_DATA_GLOBAL"]
+	 deletion_protection = false
+	 expires_in = "720h"
 	}
 	`, env.TestEmail, env.TestDeveloperId)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccCassetteProviderFactories(t),
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
@@ -43,6 +55,15 @@ resource "androidpublisher_user" "test" {
 					resource.TestCheckResourceAttr("androidpublisher_user.test", "developer_account_permissions.0", "CAN_VIEW_APP_QUALITY_GLOBAL"),
 				),
 			},
+			// ImportState testing
+			{
+				ResourceName:      "androidpublisher_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("developers/%s/users/%s", env.TestDeveloperId, env.TestEmail),
+				// deletion_protection is not returned by the API and is imported as true.
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+			},
 			// Update and Read testing
 			{
 				Config: updateConfig,
@@ -52,6 +73,13 @@ resource "androidpublisher_user" "test" {
 					resource.TestCheckResourceAttr("androidpublisher_user.test", "developer_account_permissions.#", "2"),
 				),
 			},
+			// Expiration testing
+			{
+				Config: expiringConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("androidpublisher_user.test", "expiration_time"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/lib"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/timetypes"
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+func TestUserResourceUpdateMask(t *testing.T) {
+	ctx := context.Background()
+	const expiration = "2030-01-01T00:00:00Z"
+
+	model := func(permissions []string, expirationTime timetypes.RFC3339, deletionProtection bool) *UserResourceModel {
+		return testUserModel(func(m *UserResourceModel) {
+			m.ExpirationTime = expirationTime
+			m.DeveloperAccountPermissions = lib.StrListToTfModel(permissions)
+			m.DeletionProtection = types.BoolValue(deletionProtection)
+		})
+	}
+	current := model([]string{"CAN_VIEW_APP_QUALITY_GLOBAL"}, timetypes.NewRFC3339Value(expiration), true)
+
+	tests := map[string]struct {
+		planned  *UserResourceModel
+		wantMask string
+		// wantNull is the body field that must be sent as an explicit null.
+		wantNull string
+		noPatch  bool
+	}{
+		"permissions": {
+			planned:  model([]string{"CAN_VIEW_APP_QUALITY_GLOBAL", "CAN_REPLY_TO_REVIEWS_GLOBAL"}, timetypes.NewRFC3339Value(expiration), true),
+			wantMask: "developerAccountPermissions",
+		},
+		"expiration removed": {
+			planned:  model([]string{"CAN_VIEW_APP_QUALITY_GLOBAL"}, timetypes.NewRFC3339Null(), true),
+			wantMask: "expirationTime",
+			wantNull: "expirationTime",
+		},
+		"provider-only attribute": {
+			planned: model([]string{"CAN_VIEW_APP_QUALITY_GLOBAL"}, timetypes.NewRFC3339Value(expiration), false),
+			noPatch: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fake, gCtx := newTestFakePlay(t)
+			fake.PutUser("123", &androidpublisher.User{
+				Email:                       "invitee@example.com",
+				AccessState:                 AccessStateGranted,
+				DeveloperAccountPermissions: []string{"CAN_VIEW_APP_QUALITY_GLOBAL"},
+				ExpirationTime:              expiration,
+			})
+			r := &UserResource{GoogleProviderContext: gCtx}
+
+			state := testResourceState(t, r, current)
+			plan := testResourcePlan(t, r, tt.planned)
+
+			resp := resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			patches := testRequests(fake, http.MethodPatch)
+			if tt.noPatch {
+				if len(patches) != 0 {
+					t.Errorf("expected no patch, got %v", patches)
+				}
+				return
+			}
+			if len(patches) != 1 {
+				t.Fatalf("expected a single patch, got %v", patches)
+			}
+			patch := patches[0]
+			if patch.UpdateMask != tt.wantMask {
+				t.Errorf("expected update mask %q, got %q", tt.wantMask, patch.UpdateMask)
+			}
+			if tt.wantNull != "" {
+				if value, ok := patch.Body[tt.wantNull]; !ok || value != nil {
+					t.Errorf("expected %s to be sent as null, got body %v", tt.wantNull, patch.Body)
+				}
+			}
+
+			var updated UserResourceModel
+			resp.State.Get(ctx, &updated)
+			if !updated.ExpirationTime.Equal(tt.planned.ExpirationTime) {
+				t.Errorf("expected expiration_time %s, got %s", tt.planned.ExpirationTime, updated.ExpirationTime)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/names"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+// Access states reported by the API in User.AccessState.
+const (
+	AccessStateInvited           = "INVITED"
+	AccessStateInvitationExpired = "INVITATION_EXPIRED"
+	AccessStateGranted           = "ACCESS_GRANTED"
+	AccessStateExpired           = "ACCESS_EXPIRED"
+)
+
+// acceptancePollInterval is how often WaitForAcceptance lists users.
+var acceptancePollInterval = 30 * time.Second
+
+// usersPageSize is the page size requested from Users.List. The API caps the
+// page size server side, so every listing must follow nextPageToken.
+const usersPageSize = 100
+
+// ForEachUser calls fn for every user in the developer account, following
+// nextPageToken until every page has been read. A non-nil error returned from
+// fn stops the iteration.
+func (c *GoogleProviderContext) ForEachUser(ctx context.Context, developerID string, fn func(*androidpublisher.User) error) error {
+	parent := names.Developer{ID: developerID}.String()
+
+	return c.Users.List(ctx, parent, usersPageSize, func(response *androidpublisher.ListUsersResponse) error {
+		for _, user := range response.Users {
+			if err := fn(user); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// userCache holds the result of a full Users.List per developer account so
+// that every resource refreshed in the same run shares a single listing.
+// Concurrent misses for the same developer account are collapsed into one
+// load.
+type userCache struct {
+	mu          sync.Mutex
+	users       map[string][]*androidpublisher.User
+	generations map[string]uint64
+	group       singleflight.Group
+}
+
+func (c *userCache) get(developerID string) ([]*androidpublisher.User, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	users, ok := c.users[developerID]
+	return users, c.generations[developerID], ok
+}
+
+// set stores the users unless the cache was invalidated after the load started.
+func (c *userCache) set(developerID string, generation uint64, users []*androidpublisher.User) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generations[developerID] != generation {
+		return
+	}
+	if c.users == nil {
+		c.users = make(map[string][]*androidpublisher.User)
+	}
+	c.users[developerID] = users
+}
+
+func (c *userCache) invalidate(developerID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generations == nil {
+		c.generations = make(map[string]uint64)
+	}
+	c.generations[developerID]++
+	delete(c.users, developerID)
+	c.group.Forget(developerID)
+}
+
+// ListUsers returns every user in the developer account. Results are cached
+// per developer account until InvalidateUsers is called, so the returned
+// users must not be modified.
+func (c *GoogleProviderContext) ListUsers(ctx context.Context, developerID string) ([]*androidpublisher.User, error) {
+	users, generation, ok := c.userCache.get(developerID)
+	if ok {
+		return users, nil
+	}
+
+	result, err, _ := c.userCache.group.Do(developerID, func() (interface{}, error) {
+		users, err := c.loadUsers(ctx, developerID)
+		if err != nil {
+			return nil, err
+		}
+		c.userCache.set(developerID, generation, users)
+		return users, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	users, _ = result.([]*androidpublisher.User)
+	return users, nil
+}
+
+// loadUsers lists every user in the developer account, bypassing the cache.
+func (c *GoogleProviderContext) loadUsers(ctx context.Context, developerID string) ([]*androidpublisher.User, error) {
+	var users []*androidpublisher.User
+	err := c.ForEachUser(ctx, developerID, func(user *androidpublisher.User) error {
+		users = append(users, user)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// InvalidateUsers discards the cached listing for the developer account.
+// Mutate calls it after every call that changes users or grants.
+func (c *GoogleProviderContext) InvalidateUsers(developerID string) {
+	c.userCache.invalidate(developerID)
+}
+
+// FindUser returns the user with the given email in the developer account, or nil if there is none.
+func (c *GoogleProviderContext) FindUser(ctx context.Context, developerID string, email string) (*androidpublisher.User, error) {
+	users, err := c.ListUsers(ctx, developerID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, nil
+}
+
+// WaitForAcceptance polls the developer account until the user has accepted
+// the invitation. It fails if the invitation expires or the timeout elapses,
+// returning the last user seen.
+func (c *GoogleProviderContext) WaitForAcceptance(ctx context.Context, developerID string, email string, timeout time.Duration) (*androidpublisher.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(acceptancePollInterval)
+	defer ticker.Stop()
+
+	var user *androidpublisher.User
+	for {
+		c.InvalidateUsers(developerID)
+		found, err := c.FindUser(ctx, developerID, email)
+		switch {
+		case ctx.Err() != nil:
+			return user, fmt.Errorf("timed out after %s waiting for %q to accept the invitation", timeout, email)
+		case err != nil:
+			return user, err
+		case found == nil:
+			return nil, fmt.Errorf("user %q was removed while waiting for the invitation to be accepted", email)
+		}
+		user = found
+
+		switch user.AccessState {
+		case AccessStateGranted:
+			return user, nil
+		case AccessStateInvitationExpired:
+			return user, fmt.Errorf("the invitation for %q expired before it was accepted", email)
+		}
+
+		select {
+		case <-ctx.Done():
+			return user, fmt.Errorf("timed out after %s waiting for %q to accept the invitation, last access state was %s", timeout, email, user.AccessState)
+		case <-ticker.C:
+		}
+	}
+}
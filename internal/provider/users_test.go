@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tbui17/terraform-provider-androidpublisher/internal/fakeplay"
+	"google.golang.org/api/androidpublisher/v3"
+)
+
+// testCallerEmail is the identity the provider authenticates as in tests
+// that run against the fake API.
+const testCallerEmail = "caller@example.com"
+
+// newTestProviderContext configures the provider against the given handler,
+// usually a fakeplay.Server, served in-process.
+func newTestProviderContext(t *testing.T, handler http.Handler) *GoogleProviderContext {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	providerContext, diags := configureProviderWith(context.Background(), &GoogleProvider{clientOptions: fakeplay.ClientOptions(server.URL)}, GoogleProviderModel{
+		CallerEmail:      types.StringValue(testCallerEmail),
+		EscalationErrors: types.SetNull(types.StringType),
+		GroupEmails:      types.SetNull(types.StringType),
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	return providerContext
+}
+
+// newTestFakePlay returns a fake API with the fakePackageName app and a
+// provider context that talks to it.
+func newTestFakePlay(t *testing.T) (*fakeplay.Server, *GoogleProviderContext) {
+	t.Helper()
+
+	play := fakeplay.New()
+	play.AddApp(fakePackageName)
+	return play, newTestProviderContext(t, play)
+}
+
+// testRequests returns the requests with one of the given methods that the
+// fake API received, in order.
+func testRequests(play *fakeplay.Server, methods ...string) []fakeplay.Request {
+	var requests []fakeplay.Request
+	for _, request := range play.Requests() {
+		if slices.Contains(methods, request.Method) {
+			requests = append(requests, request)
+		}
+	}
+	return requests
+}
+
+func TestListUsersFollowsNextPageToken(t *testing.T) {
+	play, gCtx := newTestFakePlay(t)
+	play.MaxPageSize = 3
+	for i := range 7 {
+		play.PutUser("123", &androidpublisher.User{Email: fmt.Sprintf("user%d@example.com", i)})
+	}
+
+	users, err := gCtx.ListUsers(context.Background(), "123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 7 {
+		t.Fatalf("expected 7 users, got %d", len(users))
+	}
+
+	user, err := gCtx.FindUser(context.Background(), "123", "user6@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user == nil {
+		t.Fatal("expected to find a user on the last page")
+	}
+}
+
+func TestListUsersSharesOneListingPerDeveloper(t *testing.T) {
+	play := fakeplay.New()
+	for i := range 5 {
+		play.PutUser("123", &androidpublisher.User{Email: fmt.Sprintf("user%d@example.com", i)})
+	}
+	play.MaxPageSize = 2
+	release := make(chan struct{})
+	gCtx := newTestProviderContext(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		play.ServeHTTP(w, r)
+	}))
+	// count returns the number of listings of the developer account, each
+	// starting with a request without a page token.
+	count := func(developerID string) int {
+		listings := 0
+		for _, request := range play.Requests() {
+			if request.Name == "developers/"+developerID+"/users" && request.PageToken == "" {
+				listings++
+			}
+		}
+		return listings
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := gCtx.FindUser(context.Background(), "123", "user4@example.com"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	close(release)
+	wg.Wait()
+
+	if got := count("123"); got != 1 {
+		t.Fatalf("expected concurrent reads to share 1 listing, got %d", got)
+	}
+
+	if _, err := gCtx.ListUsers(context.Background(), "123"); err != nil {
+		t.Fatal(err)
+	}
+	if got := count("123"); got != 1 {
+		t.Fatalf("expected cached listing to be reused, got %d listings", got)
+	}
+
+	if _, err := gCtx.ListUsers(context.Background(), "456"); err != nil {
+		t.Fatal(err)
+	}
+	if got := count("456"); got != 1 {
+		t.Fatalf("expected a separate listing for another developer account, got %d", got)
+	}
+
+	gCtx.InvalidateUsers("123")
+	if _, err := gCtx.ListUsers(context.Background(), "123"); err != nil {
+		t.Fatal(err)
+	}
+	if got := count("123"); got != 2 {
+		t.Fatalf("expected invalidation to force a new listing, got %d listings", got)
+	}
+	if got := count("456"); got != 1 {
+		t.Fatalf("expected invalidation to leave other developer accounts cached, got %d listings", got)
+	}
+}
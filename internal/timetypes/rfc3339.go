@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = RFC3339Type{}
+
+// RFC3339Type is a string type holding an RFC3339 timestamp. Values that
+// describe the same instant, e.g. in different time zones or with fractional
+// seconds, are semantically equal, so the API's normalized form of a
+// configured timestamp does not cause a diff.
+type RFC3339Type struct {
+	basetypes.StringType
+}
+
+func (t RFC3339Type) String() string {
+	return "timetypes.RFC3339Type"
+}
+
+func (t RFC3339Type) ValueType(ctx context.Context) attr.Value {
+	return RFC3339{}
+}
+
+func (t RFC3339Type) Equal(o attr.Type) bool {
+	other, ok := o.(RFC3339Type)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t RFC3339Type) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return RFC3339{StringValue: in}, nil
+}
+
+func (t RFC3339Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = RFC3339{}
+	_ xattr.ValidateableAttribute                = RFC3339{}
+)
+
+// RFC3339 is a value of RFC3339Type.
+type RFC3339 struct {
+	basetypes.StringValue
+}
+
+func NewRFC3339Null() RFC3339 {
+	return RFC3339{StringValue: basetypes.NewStringNull()}
+}
+
+func NewRFC3339Unknown() RFC3339 {
+	return RFC3339{StringValue: basetypes.NewStringUnknown()}
+}
+
+func NewRFC3339Value(value string) RFC3339 {
+	return RFC3339{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewRFC3339TimeValue(value time.Time) RFC3339 {
+	return NewRFC3339Value(value.Format(time.RFC3339))
+}
+
+func (v RFC3339) Type(ctx context.Context) attr.Type {
+	return RFC3339Type{}
+}
+
+func (v RFC3339) Equal(o attr.Value) bool {
+	other, ok := o.(RFC3339)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values describe the same instant.
+func (v RFC3339) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(RFC3339)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	oldTime, err := time.Parse(time.RFC3339, v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	newTime, err := time.Parse(time.RFC3339, newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return oldTime.Equal(newTime), diags
+}
+
+func (v RFC3339) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid RFC3339 timestamp",
+			fmt.Sprintf("Expected a timestamp such as \"2030-01-02T15:04:05Z\", got %q: %v", v.ValueString(), err),
+		)
+	}
+}
+
+// ValueTime parses the value. It must only be called on known, valid values.
+func (v RFC3339) ValueTime() (time.Time, error) {
+	return time.Parse(time.RFC3339, v.ValueString())
+}
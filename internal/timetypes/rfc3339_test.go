@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestRFC3339SemanticEquals(t *testing.T) {
+	tests := map[string]struct {
+		old, new string
+		want     bool
+	}{
+		"identical":          {"2030-01-02T15:04:05Z", "2030-01-02T15:04:05Z", true},
+		"fractional seconds": {"2030-01-02T15:04:05Z", "2030-01-02T15:04:05.000Z", true},
+		"time zone offset":   {"2030-01-02T15:04:05Z", "2030-01-02T17:04:05+02:00", true},
+		"different instant":  {"2030-01-02T15:04:05Z", "2030-01-02T15:04:06Z", false},
+		"invalid":            {"2030-01-02T15:04:05Z", "tomorrow", false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, diags := NewRFC3339Value(tt.old).StringSemanticEquals(context.Background(), NewRFC3339Value(tt.new))
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+			if got != tt.want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", tt.old, tt.new, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRFC3339ValidateAttribute(t *testing.T) {
+	tests := map[string]struct {
+		value   RFC3339
+		wantErr bool
+	}{
+		"valid":   {NewRFC3339Value("2030-01-02T15:04:05Z"), false},
+		"null":    {NewRFC3339Null(), false},
+		"unknown": {NewRFC3339Unknown(), false},
+		"date":    {NewRFC3339Value("2030-01-02"), true},
+		"invalid": {NewRFC3339Value("next week"), true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var resp xattr.ValidateAttributeResponse
+			tt.value.ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("expiration_time")}, &resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("ValidateAttribute() diagnostics = %v, wantErr %v", resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Config selects the API types to generate and overrides how their fields
+// map to Terraform attributes. Types referenced by a selected type are
+// generated too, with default semantics unless they are listed.
+type Config struct {
+	// Package is the name of the generated package.
+	Package string `json:"package"`
+	// Schemas are keyed by the name of the type in the discovery document.
+	Schemas map[string]SchemaConfig `json:"schemas"`
+}
+
+// SchemaConfig holds the overrides of a type.
+type SchemaConfig struct {
+	// Fields are keyed by the property name in the discovery document.
+	Fields map[string]FieldConfig `json:"fields"`
+}
+
+// FieldConfig overrides the semantics of a field. By default, fields the
+// API marks as read-only are computed and every other field is optional.
+type FieldConfig struct {
+	// Name replaces the attribute name derived from the property name.
+	Name      string `json:"name,omitempty"`
+	Required  *bool  `json:"required,omitempty"`
+	Optional  *bool  `json:"optional,omitempty"`
+	Computed  *bool  `json:"computed,omitempty"`
+	Sensitive bool   `json:"sensitive,omitempty"`
+	// Set makes an array field a set, for arrays whose order is not
+	// meaningful.
+	Set bool `json:"set,omitempty"`
+	// Skip leaves the field out of the model.
+	Skip bool `json:"skip,omitempty"`
+}
+
+// LoadConfig reads a config file. Unknown keys are rejected so that a typo
+// does not silently drop an override.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	var config Config
+	if err := decoder.Decode(&config); err != nil {
+		return nil, fmt.Errorf("unable to parse config %s: %w", path, err)
+	}
+	if config.Package == "" {
+		return nil, fmt.Errorf("config %s does not set package", path)
+	}
+	return &config, nil
+}
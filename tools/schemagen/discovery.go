@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Document is the part of a Google API discovery document the generator reads.
+type Document struct {
+	Name     string             `json:"name"`
+	Version  string             `json:"version"`
+	Revision string             `json:"revision"`
+	Schemas  map[string]*Schema `json:"schemas"`
+}
+
+// Schema is a type of the API, or the type of one of its properties.
+type Schema struct {
+	ID                   string             `json:"id"`
+	Type                 string             `json:"type"`
+	Format               string             `json:"format"`
+	Description          string             `json:"description"`
+	Ref                  string             `json:"$ref"`
+	ReadOnly             bool               `json:"readOnly"`
+	Enum                 []string           `json:"enum"`
+	Properties           map[string]*Schema `json:"properties"`
+	Items                *Schema            `json:"items"`
+	AdditionalProperties *Schema            `json:"additionalProperties"`
+}
+
+// LoadDocument reads a discovery document.
+func LoadDocument(path string) (*Document, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc Document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("unable to parse discovery document %s: %w", path, err)
+	}
+	return &doc, nil
+}
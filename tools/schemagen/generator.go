@@ -0,0 +1,566 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/scanner"
+	"go/token"
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// valueKind is the kind of a scalar, or of the elements of a collection.
+type valueKind int
+
+const (
+	kindString valueKind = iota
+	kindInt64
+	kindBool
+	kindFloat64
+	kindObject
+)
+
+// collectionKind is how a field holds its values.
+type collectionKind int
+
+const (
+	collectionNone collectionKind = iota
+	collectionList
+	collectionSet
+	collectionMap
+)
+
+// Field is a property of an API type and the attribute it maps to.
+type Field struct {
+	Property    string
+	GoName      string
+	AttrName    string
+	Description string
+	Collection  collectionKind
+	Kind        valueKind
+	// Ref is the API type of object values.
+	Ref       string
+	Required  bool
+	Optional  bool
+	Computed  bool
+	Sensitive bool
+}
+
+// Model is an API type and the Terraform model generated for it.
+type Model struct {
+	Name        string
+	Description string
+	Fields      []Field
+}
+
+// BuildModels returns the models of the configured types and of every type
+// they reference, sorted by name.
+func BuildModels(doc *Document, config *Config) ([]*Model, error) {
+	var queue []string
+	for name := range config.Schemas {
+		if doc.Schemas[name] == nil {
+			return nil, fmt.Errorf("schema %s is not in the discovery document", name)
+		}
+		queue = append(queue, name)
+	}
+	sort.Strings(queue)
+
+	models := make(map[string]*Model)
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if models[name] != nil {
+			continue
+		}
+		model, err := buildModel(doc, name, config.Schemas[name])
+		if err != nil {
+			return nil, err
+		}
+		models[name] = model
+		for _, field := range model.Fields {
+			if field.Kind == kindObject {
+				if doc.Schemas[field.Ref] == nil {
+					return nil, fmt.Errorf("%s.%s references unknown schema %s", name, field.Property, field.Ref)
+				}
+				queue = append(queue, field.Ref)
+			}
+		}
+	}
+
+	if err := checkCycles(models); err != nil {
+		return nil, err
+	}
+
+	result := make([]*Model, 0, len(models))
+	for _, model := range models {
+		result = append(result, model)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+func buildModel(doc *Document, name string, config SchemaConfig) (*Model, error) {
+	schema := doc.Schemas[name]
+	for property := range config.Fields {
+		if schema.Properties[property] == nil {
+			return nil, fmt.Errorf("override of %s.%s: no such property", name, property)
+		}
+	}
+
+	model := &Model{Name: name, Description: schema.Description}
+	attrNames := make(map[string]string)
+	for property, prop := range schema.Properties {
+		override := config.Fields[property]
+		if override.Skip {
+			continue
+		}
+		field, err := buildField(doc, property, prop, override)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", name, property, err)
+		}
+		if other, ok := attrNames[field.AttrName]; ok {
+			return nil, fmt.Errorf("%s.%s and %s.%s both map to attribute %s, rename one of them", name, property, name, other, field.AttrName)
+		}
+		attrNames[field.AttrName] = property
+		model.Fields = append(model.Fields, field)
+	}
+	sort.Slice(model.Fields, func(i, j int) bool { return model.Fields[i].AttrName < model.Fields[j].AttrName })
+	return model, nil
+}
+
+func buildField(doc *Document, property string, prop *Schema, override FieldConfig) (Field, error) {
+	field := Field{
+		Property:    property,
+		GoName:      goName(property),
+		AttrName:    attrName(property),
+		Description: prop.Description,
+		Optional:    !prop.ReadOnly,
+		Computed:    prop.ReadOnly,
+		Sensitive:   override.Sensitive,
+	}
+	if override.Name != "" {
+		field.AttrName = override.Name
+	}
+
+	value := prop
+	switch {
+	case prop.Type == "array":
+		field.Collection = collectionList
+		if override.Set {
+			field.Collection = collectionSet
+		}
+		value = prop.Items
+	case prop.Type == "object" && prop.AdditionalProperties != nil:
+		field.Collection = collectionMap
+		value = prop.AdditionalProperties
+	}
+	if override.Set && field.Collection != collectionList && field.Collection != collectionSet {
+		return Field{}, fmt.Errorf("only arrays can be sets")
+	}
+	if value == nil {
+		return Field{}, fmt.Errorf("collection without element type")
+	}
+
+	switch {
+	case value.Ref != "":
+		field.Kind = kindObject
+		field.Ref = value.Ref
+		if field.Description == "" {
+			field.Description = doc.Schemas[value.Ref].Description
+		}
+	case value.Type == "string" && (value.Format == "int64" || value.Format == "uint64"):
+		field.Kind = kindInt64
+	case value.Type == "string":
+		field.Kind = kindString
+	case value.Type == "integer":
+		field.Kind = kindInt64
+	case value.Type == "boolean":
+		field.Kind = kindBool
+	case value.Type == "number":
+		field.Kind = kindFloat64
+	default:
+		return Field{}, fmt.Errorf("unsupported type %q, skip the field", value.Type)
+	}
+	if len(value.Enum) > 0 {
+		field.Description = strings.TrimSpace(field.Description + " Possible values are `" + strings.Join(value.Enum, "`, `") + "`.")
+	}
+
+	if override.Optional != nil {
+		field.Optional = *override.Optional
+	}
+	if override.Computed != nil {
+		field.Computed = *override.Computed
+	}
+	if override.Required != nil {
+		field.Required = *override.Required
+		if field.Required {
+			field.Optional = false
+			field.Computed = false
+		}
+	}
+	if !field.Required && !field.Optional && !field.Computed {
+		return Field{}, fmt.Errorf("attribute must be required, optional or computed")
+	}
+	return field, nil
+}
+
+// checkCycles rejects types that reference themselves, which Terraform
+// types cannot express.
+func checkCycles(models map[string]*Model) error {
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := make(map[string]int)
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("schema %s references itself through %s", name, strings.Join(append(path, name), " -> "))
+		case done:
+			return nil
+		}
+		state[name] = visiting
+		for _, field := range models[name].Fields {
+			if field.Kind == kindObject {
+				if err := visit(field.Ref, append(path, name)); err != nil {
+					return err
+				}
+			}
+		}
+		state[name] = done
+		return nil
+	}
+
+	names := make([]string, 0, len(models))
+	for name := range models {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// goName is the name of the field of a property in the API client, which
+// capitalizes the property name.
+func goName(property string) string {
+	return strings.ToUpper(property[:1]) + property[1:]
+}
+
+// attrName converts a camelCase property name to snake_case, keeping
+// acronyms together: "sdkURL" becomes "sdk_url".
+func attrName(property string) string {
+	runes := []rune(property)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			previous := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(previous) || unicode.IsDigit(previous) || (unicode.IsUpper(previous) && nextLower) {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// File is a generated source file.
+type File struct {
+	Name    string
+	Content []byte
+}
+
+// Generate renders a file for every model.
+func Generate(doc *Document, config *Config, models []*Model) ([]File, error) {
+	files := make([]File, 0, len(models))
+	for _, model := range models {
+		content, err := render(doc, config, model)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", model.Name, err)
+		}
+		files = append(files, File{Name: attrName(model.Name) + "_gen.go", Content: content})
+	}
+	return files, nil
+}
+
+// imports are the packages generated code can use, by their name.
+var imports = []struct{ name, path string }{
+	{"context", "context"},
+	{"attr", "github.com/hashicorp/terraform-plugin-framework/attr"},
+	{"diag", "github.com/hashicorp/terraform-plugin-framework/diag"},
+	{"dsschema", "github.com/hashicorp/terraform-plugin-framework/datasource/schema"},
+	{"schema", "github.com/hashicorp/terraform-plugin-framework/resource/schema"},
+	{"types", "github.com/hashicorp/terraform-plugin-framework/types"},
+	{"androidpublisher", "google.golang.org/api/androidpublisher/v3"},
+}
+
+// selectors returns the identifiers the code selects from, such as "types"
+// in "types.String", skipping comments and string literals.
+func selectors(code []byte) map[string]bool {
+	fset := token.NewFileSet()
+	var s scanner.Scanner
+	s.Init(fset.AddFile("", fset.Base(), len(code)), code, nil, 0)
+
+	result := make(map[string]bool)
+	var previous string
+	for {
+		_, tok, lit := s.Scan()
+		if tok == token.EOF {
+			return result
+		}
+		if tok == token.PERIOD && previous != "" {
+			result[previous] = true
+		}
+		previous = ""
+		if tok == token.IDENT {
+			previous = lit
+		}
+	}
+}
+
+func render(doc *Document, config *Config, model *Model) ([]byte, error) {
+	var body bytes.Buffer
+	renderModel(&body, model)
+	renderAttrTypes(&body, model)
+	renderAttributes(&body, model)
+	renderDataSourceAttributes(&body, model)
+	renderFromAPI(&body, model)
+	renderToAPI(&body, model)
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Copyright (c) HashiCorp, Inc.\n// SPDX-License-Identifier: MPL-2.0\n\n")
+	fmt.Fprintf(&out, "// Code generated by schemagen from the %s %s discovery document, revision %s. DO NOT EDIT.\n\n", doc.Name, doc.Version, doc.Revision)
+	fmt.Fprintf(&out, "package %s\n\nimport (\n", config.Package)
+	used := selectors(body.Bytes())
+	for i, imp := range imports {
+		if !used[imp.name] {
+			continue
+		}
+		if path.Base(imp.path) == imp.name {
+			fmt.Fprintf(&out, "\t%q\n", imp.path)
+		} else {
+			fmt.Fprintf(&out, "\t%s %q\n", imp.name, imp.path)
+		}
+		// Separate the standard library from other packages.
+		if i == 0 {
+			fmt.Fprintf(&out, "\n")
+		}
+	}
+	fmt.Fprintf(&out, ")\n")
+	out.Write(body.Bytes())
+
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("unable to format generated code: %w\n%s", err, out.Bytes())
+	}
+	return formatted, nil
+}
+
+// comment formats text as a doc comment.
+func comment(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	var lines []string
+	var line string
+	for _, word := range strings.Fields(text) {
+		if line != "" && len(line)+len(word) > 76 {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	lines = append(lines, line)
+	return "// " + strings.Join(lines, "\n// ") + "\n"
+}
+
+func modelName(name string) string { return name + "Model" }
+
+func attrTypesName(name string) string { return name + "AttrTypes" }
+
+func attributesName(name string) string { return name + "Attributes" }
+
+func dataSourceAttributesName(name string) string { return name + "DataSourceAttributes" }
+
+// scalarNames are the framework names of each value kind.
+var scalarNames = map[valueKind]string{
+	kindString:  "String",
+	kindInt64:   "Int64",
+	kindBool:    "Bool",
+	kindFloat64: "Float64",
+}
+
+// collectionNames are the framework names of each collection kind.
+var collectionNames = map[collectionKind]string{
+	collectionList: "List",
+	collectionSet:  "Set",
+	collectionMap:  "Map",
+}
+
+func (f Field) valueType() string {
+	switch {
+	case f.Collection != collectionNone:
+		return "types." + collectionNames[f.Collection]
+	case f.Kind == kindObject:
+		return "types.Object"
+	}
+	return "types." + scalarNames[f.Kind]
+}
+
+func (f Field) elemType() string {
+	if f.Kind == kindObject {
+		return fmt.Sprintf("types.ObjectType{AttrTypes: %s}", attrTypesName(f.Ref))
+	}
+	return "types." + scalarNames[f.Kind] + "Type"
+}
+
+func (f Field) attrType() string {
+	if f.Collection == collectionNone {
+		return f.elemType()
+	}
+	return fmt.Sprintf("types.%sType{ElemType: %s}", collectionNames[f.Collection], f.elemType())
+}
+
+// nullIfZero reports whether zero values from the API are stored as null.
+// The API omits zero values, so an optional attribute left out of the
+// configuration must stay null.
+func (f Field) nullIfZero() bool {
+	return !f.Required && !f.Computed
+}
+
+func renderModel(w *bytes.Buffer, model *Model) {
+	fmt.Fprint(w, comment(fmt.Sprintf("%s is the Terraform model of androidpublisher.%s. %s", modelName(model.Name), model.Name, model.Description)))
+	fmt.Fprintf(w, "type %s struct {\n", modelName(model.Name))
+	for _, field := range model.Fields {
+		fmt.Fprintf(w, "\t%s %s `tfsdk:%q`\n", field.GoName, field.valueType(), field.AttrName)
+	}
+	fmt.Fprintf(w, "}\n\n")
+}
+
+func renderAttrTypes(w *bytes.Buffer, model *Model) {
+	fmt.Fprintf(w, "// %s are the attribute types of %s.\n", attrTypesName(model.Name), modelName(model.Name))
+	fmt.Fprintf(w, "var %s = map[string]attr.Type{\n", attrTypesName(model.Name))
+	for _, field := range model.Fields {
+		fmt.Fprintf(w, "\t%q: %s,\n", field.AttrName, field.attrType())
+	}
+	fmt.Fprintf(w, "}\n\n")
+}
+
+func renderAttributes(w *bytes.Buffer, model *Model) {
+	fmt.Fprintf(w, "// %s returns the resource schema attributes of %s.\n", attributesName(model.Name), modelName(model.Name))
+	fmt.Fprintf(w, "func %s() map[string]schema.Attribute {\n\treturn map[string]schema.Attribute{\n", attributesName(model.Name))
+	for _, field := range model.Fields {
+		renderAttribute(w, field, "schema", attributesName, field.Required, field.Optional, field.Computed)
+	}
+	fmt.Fprintf(w, "\t}\n}\n\n")
+}
+
+// renderDataSourceAttributes renders the attributes for reading the type in
+// a data source, where every attribute is computed.
+func renderDataSourceAttributes(w *bytes.Buffer, model *Model) {
+	fmt.Fprintf(w, "// %s returns the data source schema attributes of %s,\n// which are all computed.\n", dataSourceAttributesName(model.Name), modelName(model.Name))
+	fmt.Fprintf(w, "func %s() map[string]dsschema.Attribute {\n\treturn map[string]dsschema.Attribute{\n", dataSourceAttributesName(model.Name))
+	for _, field := range model.Fields {
+		renderAttribute(w, field, "dsschema", dataSourceAttributesName, false, false, true)
+	}
+	fmt.Fprintf(w, "\t}\n}\n\n")
+}
+
+// renderAttribute renders the schema attribute of a field from the schema
+// package pkg. nested names the attributes function of nested objects.
+func renderAttribute(w *bytes.Buffer, field Field, pkg string, nested func(string) string, required, optional, computed bool) {
+	var kind, extra string
+	switch {
+	case field.Kind == kindObject && field.Collection == collectionNone:
+		kind = "SingleNestedAttribute"
+		extra = fmt.Sprintf("Attributes: %s(),", nested(field.Ref))
+	case field.Kind == kindObject:
+		kind = collectionNames[field.Collection] + "NestedAttribute"
+		extra = fmt.Sprintf("NestedObject: %s.NestedAttributeObject{Attributes: %s()},", pkg, nested(field.Ref))
+	case field.Collection != collectionNone:
+		kind = collectionNames[field.Collection] + "Attribute"
+		extra = fmt.Sprintf("ElementType: %s,", field.elemType())
+	default:
+		kind = scalarNames[field.Kind] + "Attribute"
+	}
+	fmt.Fprintf(w, "\t\t%q: %s.%s{\n", field.AttrName, pkg, kind)
+	if field.Description != "" {
+		fmt.Fprintf(w, "\t\t\tMarkdownDescription: %q,\n", strings.Join(strings.Fields(field.Description), " "))
+	}
+	for _, flag := range []struct {
+		name string
+		set  bool
+	}{{"Required", required}, {"Optional", optional}, {"Computed", computed}, {"Sensitive", field.Sensitive}} {
+		if flag.set {
+			fmt.Fprintf(w, "\t\t\t%s: true,\n", flag.name)
+		}
+	}
+	if extra != "" {
+		fmt.Fprintf(w, "\t\t\t%s\n", extra)
+	}
+	fmt.Fprintf(w, "\t\t},\n")
+}
+
+func renderFromAPI(w *bytes.Buffer, model *Model) {
+	fmt.Fprintf(w, "// FromAPI sets the model from an API object. Zero values the API omits\n// are null unless the attribute is required or computed.\n")
+	fmt.Fprintf(w, "func (m *%s) FromAPI(ctx context.Context, in *androidpublisher.%s) diag.Diagnostics {\n", modelName(model.Name), model.Name)
+	fmt.Fprintf(w, "\tvar diags diag.Diagnostics\n\tif in == nil {\n\t\tin = &androidpublisher.%s{}\n\t}\n", model.Name)
+	for _, f := range model.Fields {
+		switch {
+		case f.Kind == kindObject && f.Collection == collectionNone:
+			fmt.Fprintf(w, "\tif in.%[1]s != nil {\n\t\tvar model %[2]s\n\t\tdiags.Append(model.FromAPI(ctx, in.%[1]s)...)\n", f.GoName, modelName(f.Ref))
+			fmt.Fprintf(w, "\t\tvalue, d := types.ObjectValueFrom(ctx, %[1]s, model)\n\t\tdiags.Append(d...)\n\t\tm.%[2]s = value\n", attrTypesName(f.Ref), f.GoName)
+			fmt.Fprintf(w, "\t} else {\n\t\tm.%s = types.ObjectNull(%s)\n\t}\n", f.GoName, attrTypesName(f.Ref))
+		case f.Kind == kindObject && f.Collection == collectionMap:
+			fmt.Fprintf(w, "\t{\n\t\tmodels := make(map[string]%[1]s, len(in.%[2]s))\n\t\tfor key, item := range in.%[2]s {\n", modelName(f.Ref), f.GoName)
+			fmt.Fprintf(w, "\t\t\tvar model %s\n\t\t\tdiags.Append(model.FromAPI(ctx, &item)...)\n\t\t\tmodels[key] = model\n\t\t}\n", modelName(f.Ref))
+			fmt.Fprintf(w, "\t\tvalue, d := mapFromAPI(ctx, %s, models, %t)\n\t\tdiags.Append(d...)\n\t\tm.%s = value\n\t}\n", f.elemType(), f.nullIfZero(), f.GoName)
+		case f.Kind == kindObject:
+			fmt.Fprintf(w, "\t{\n\t\tmodels := make([]%[1]s, 0, len(in.%[2]s))\n\t\tfor _, item := range in.%[2]s {\n\t\t\tif item == nil {\n\t\t\t\tcontinue\n\t\t\t}\n", modelName(f.Ref), f.GoName)
+			fmt.Fprintf(w, "\t\t\tvar model %s\n\t\t\tdiags.Append(model.FromAPI(ctx, item)...)\n\t\t\tmodels = append(models, model)\n\t\t}\n", modelName(f.Ref))
+			fmt.Fprintf(w, "\t\tvalue, d := %sFromAPI(ctx, %s, models, %t)\n\t\tdiags.Append(d...)\n\t\tm.%s = value\n\t}\n", strings.ToLower(collectionNames[f.Collection]), f.elemType(), f.nullIfZero(), f.GoName)
+		case f.Collection != collectionNone:
+			fmt.Fprintf(w, "\t{\n\t\tvalue, d := %sFromAPI(ctx, %s, in.%s, %t)\n\t\tdiags.Append(d...)\n\t\tm.%s = value\n\t}\n", strings.ToLower(collectionNames[f.Collection]), f.elemType(), f.GoName, f.nullIfZero(), f.GoName)
+		default:
+			fmt.Fprintf(w, "\tm.%s = %sFromAPI(in.%s, %t)\n", f.GoName, strings.ToLower(scalarNames[f.Kind][:1])+scalarNames[f.Kind][1:], f.GoName, f.nullIfZero())
+		}
+	}
+	fmt.Fprintf(w, "\treturn diags\n}\n\n")
+}
+
+func renderToAPI(w *bytes.Buffer, model *Model) {
+	fmt.Fprintf(w, "// ToAPI returns the API object of the model. Null and unknown values are\n// left empty.\n")
+	fmt.Fprintf(w, "func (m %s) ToAPI(ctx context.Context) (*androidpublisher.%s, diag.Diagnostics) {\n", modelName(model.Name), model.Name)
+	fmt.Fprintf(w, "\tvar diags diag.Diagnostics\n\tout := &androidpublisher.%s{}\n", model.Name)
+	for _, f := range model.Fields {
+		switch {
+		case f.Kind == kindObject && f.Collection == collectionNone:
+			fmt.Fprintf(w, "\t{\n\t\tmodel, d := objectAs[%s](ctx, m.%s)\n\t\tdiags.Append(d...)\n", modelName(f.Ref), f.GoName)
+			fmt.Fprintf(w, "\t\tif model != nil {\n\t\t\tout.%s, d = model.ToAPI(ctx)\n\t\t\tdiags.Append(d...)\n\t\t}\n\t}\n", f.GoName)
+		case f.Kind == kindObject && f.Collection == collectionMap:
+			fmt.Fprintf(w, "\t{\n\t\tvar models map[string]%s\n\t\tdiags.Append(elementsAs(ctx, m.%s, &models)...)\n", modelName(f.Ref), f.GoName)
+			fmt.Fprintf(w, "\t\tfor key, model := range models {\n\t\t\titem, d := model.ToAPI(ctx)\n\t\t\tdiags.Append(d...)\n")
+			fmt.Fprintf(w, "\t\t\tif out.%[1]s == nil {\n\t\t\t\tout.%[1]s = make(map[string]androidpublisher.%[2]s, len(models))\n\t\t\t}\n\t\t\tout.%[1]s[key] = *item\n\t\t}\n\t}\n", f.GoName, f.Ref)
+		case f.Kind == kindObject:
+			fmt.Fprintf(w, "\t{\n\t\tvar models []%s\n\t\tdiags.Append(elementsAs(ctx, m.%s, &models)...)\n", modelName(f.Ref), f.GoName)
+			fmt.Fprintf(w, "\t\tfor _, model := range models {\n\t\t\titem, d := model.ToAPI(ctx)\n\t\t\tdiags.Append(d...)\n\t\t\tout.%[1]s = append(out.%[1]s, item)\n\t\t}\n\t}\n", f.GoName)
+		case f.Collection != collectionNone:
+			fmt.Fprintf(w, "\tdiags.Append(elementsAs(ctx, m.%s, &out.%s)...)\n", f.GoName, f.GoName)
+		default:
+			fmt.Fprintf(w, "\tout.%s = m.%s.Value%s()\n", f.GoName, f.GoName, scalarNames[f.Kind])
+		}
+	}
+	fmt.Fprintf(w, "\treturn out, diags\n}\n")
+}
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func boolPtr(value bool) *bool {
+	return &value
+}
+
+// testDocument has a type for every kind of property.
+func testDocument() *Document {
+	return &Document{
+		Name:     "androidpublisher",
+		Version:  "v3",
+		Revision: "1",
+		Schemas: map[string]*Schema{
+			"Widget": {
+				ID:          "Widget",
+				Type:        "object",
+				Description: "A widget.",
+				Properties: map[string]*Schema{
+					"name":        {Type: "string", Description: "The name."},
+					"state":       {Type: "string", ReadOnly: true, Enum: []string{"ON", "OFF"}},
+					"count":       {Type: "integer", Format: "int32"},
+					"bigCount":    {Type: "string", Format: "int64"},
+					"enabled":     {Type: "boolean"},
+					"ratio":       {Type: "number", Format: "double"},
+					"tags":        {Type: "array", Items: &Schema{Type: "string"}},
+					"secret":      {Type: "string"},
+					"part":        {Ref: "Part"},
+					"parts":       {Type: "array", Items: &Schema{Ref: "Part"}},
+					"partsByName": {Type: "object", AdditionalProperties: &Schema{Ref: "Part"}},
+					"sdkURL":      {Type: "string"},
+				},
+			},
+			"Part": {
+				ID:          "Part",
+				Type:        "object",
+				Description: "A part.",
+				Properties: map[string]*Schema{
+					"id": {Type: "string"},
+				},
+			},
+		},
+	}
+}
+
+func findField(t *testing.T, models []*Model, model string, property string) Field {
+	t.Helper()
+	for _, m := range models {
+		if m.Name != model {
+			continue
+		}
+		for _, field := range m.Fields {
+			if field.Property == property {
+				return field
+			}
+		}
+	}
+	t.Fatalf("no field %s.%s", model, property)
+	return Field{}
+}
+
+func TestBuildModels(t *testing.T) {
+	config := &Config{Package: "test", Schemas: map[string]SchemaConfig{
+		"Widget": {Fields: map[string]FieldConfig{
+			"name":   {Required: boolPtr(true)},
+			"count":  {Computed: boolPtr(true)},
+			"tags":   {Set: true},
+			"secret": {Sensitive: true, Name: "api_secret"},
+			"ratio":  {Skip: true},
+		}},
+	}}
+	models, err := BuildModels(testDocument(), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(models) != 2 || models[0].Name != "Part" || models[1].Name != "Widget" {
+		t.Fatalf("expected the referenced Part to be generated, got %v", models)
+	}
+
+	tests := map[string]struct {
+		field                                   Field
+		required, optional, computed, sensitive bool
+	}{
+		"required":  {field: findField(t, models, "Widget", "name"), required: true},
+		"read-only": {field: findField(t, models, "Widget", "state"), computed: true},
+		"computed":  {field: findField(t, models, "Widget", "count"), optional: true, computed: true},
+		"optional":  {field: findField(t, models, "Widget", "enabled"), optional: true},
+		"sensitive": {field: findField(t, models, "Widget", "secret"), optional: true, sensitive: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := tt.field
+			if f.Required != tt.required || f.Optional != tt.optional || f.Computed != tt.computed || f.Sensitive != tt.sensitive {
+				t.Errorf("unexpected semantics %+v", f)
+			}
+		})
+	}
+
+	if f := findField(t, models, "Widget", "tags"); f.Collection != collectionSet || f.Kind != kindString {
+		t.Errorf("expected tags to be a set of strings, got %+v", f)
+	}
+	if f := findField(t, models, "Widget", "bigCount"); f.Kind != kindInt64 || f.AttrName != "big_count" {
+		t.Errorf("expected bigCount to be an int64 named big_count, got %+v", f)
+	}
+	if f := findField(t, models, "Widget", "partsByName"); f.Collection != collectionMap || f.Ref != "Part" {
+		t.Errorf("expected partsByName to be a map of parts, got %+v", f)
+	}
+	if f := findField(t, models, "Widget", "secret"); f.AttrName != "api_secret" {
+		t.Errorf("expected secret to be renamed, got %+v", f)
+	}
+	if f := findField(t, models, "Widget", "state"); !strings.HasSuffix(f.Description, "Possible values are `ON`, `OFF`.") {
+		t.Errorf("expected the enum values to be described, got %q", f.Description)
+	}
+	for _, field := range models[1].Fields {
+		if field.Property == "ratio" {
+			t.Error("expected ratio to be skipped")
+		}
+	}
+}
+
+func TestBuildModelsErrors(t *testing.T) {
+	tests := map[string]struct {
+		modify func(doc *Document)
+		config map[string]SchemaConfig
+		err    string
+	}{
+		"unknown schema": {
+			config: map[string]SchemaConfig{"Gadget": {}},
+			err:    "schema Gadget is not in the discovery document",
+		},
+		"unknown property": {
+			config: map[string]SchemaConfig{"Widget": {Fields: map[string]FieldConfig{"colour": {Required: boolPtr(true)}}}},
+			err:    "override of Widget.colour: no such property",
+		},
+		"set of a scalar": {
+			config: map[string]SchemaConfig{"Widget": {Fields: map[string]FieldConfig{"name": {Set: true}}}},
+			err:    "only arrays can be sets",
+		},
+		"no semantics": {
+			config: map[string]SchemaConfig{"Widget": {Fields: map[string]FieldConfig{"name": {Optional: boolPtr(false)}}}},
+			err:    "attribute must be required, optional or computed",
+		},
+		"unsupported type": {
+			modify: func(doc *Document) {
+				doc.Schemas["Widget"].Properties["extra"] = &Schema{Type: "any"}
+			},
+			config: map[string]SchemaConfig{"Widget": {}},
+			err:    `Widget.extra: unsupported type "any"`,
+		},
+		"attribute collision": {
+			modify: func(doc *Document) {
+				doc.Schemas["Widget"].Properties["big_count"] = &Schema{Type: "string"}
+			},
+			config: map[string]SchemaConfig{"Widget": {}},
+			err:    "both map to attribute big_count",
+		},
+		"cycle": {
+			modify: func(doc *Document) {
+				doc.Schemas["Part"].Properties["widget"] = &Schema{Ref: "Widget"}
+			},
+			config: map[string]SchemaConfig{"Widget": {}},
+			err:    "references itself",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			doc := testDocument()
+			if tt.modify != nil {
+				tt.modify(doc)
+			}
+			_, err := BuildModels(doc, &Config{Package: "test", Schemas: tt.config})
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("expected an error containing %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestAttrName(t *testing.T) {
+	tests := map[string]string{
+		"name":                        "name",
+		"developerAccountPermissions": "developer_account_permissions",
+		"sdkURL":                      "sdk_url",
+		"sha256":                      "sha256",
+		"iapIDList":                   "iap_id_list",
+		"price1Micros":                "price1_micros",
+	}
+	for property, expected := range tests {
+		if got := attrName(property); got != expected {
+			t.Errorf("expected %s to become %s, got %s", property, expected, got)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	doc := testDocument()
+	config := &Config{Package: "test", Schemas: map[string]SchemaConfig{
+		"Widget": {Fields: map[string]FieldConfig{"secret": {Sensitive: true}}},
+	}}
+	models, err := BuildModels(doc, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := Generate(doc, config, models)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 || files[0].Name != "part_gen.go" || files[1].Name != "widget_gen.go" {
+		t.Fatalf("unexpected files %v", files)
+	}
+
+	// Compare without the alignment gofmt adds.
+	normalize := func(code string) string { return strings.Join(strings.Fields(code), " ") }
+	widget := normalize(string(files[1].Content))
+	for _, expected := range []string{
+		"// Code generated by schemagen from the androidpublisher v3 discovery document, revision 1. DO NOT EDIT.",
+		"type WidgetModel struct {",
+		"PartsByName types.Map `tfsdk:\"parts_by_name\"`",
+		"\"parts\": types.ListType{ElemType: types.ObjectType{AttrTypes: PartAttrTypes}},",
+		"\"secret\": schema.StringAttribute{ Optional: true, Sensitive: true, },",
+		"dsschema \"github.com/hashicorp/terraform-plugin-framework/datasource/schema\"",
+		"func WidgetDataSourceAttributes() map[string]dsschema.Attribute {",
+		"\"name\": dsschema.StringAttribute{ MarkdownDescription: \"The name.\", Computed: true, },",
+		"\"secret\": dsschema.StringAttribute{ Computed: true, Sensitive: true, },",
+		"\"parts\": dsschema.ListNestedAttribute{ MarkdownDescription: \"A part.\", Computed: true, NestedObject: dsschema.NestedAttributeObject{Attributes: PartDataSourceAttributes()}, },",
+		"func (m *WidgetModel) FromAPI(ctx context.Context, in *androidpublisher.Widget) diag.Diagnostics {",
+		"func (m WidgetModel) ToAPI(ctx context.Context) (*androidpublisher.Widget, diag.Diagnostics) {",
+		"m.State = stringFromAPI(in.State, false)",
+		"m.Name = stringFromAPI(in.Name, true)",
+	} {
+		if !strings.Contains(widget, normalize(expected)) {
+			t.Errorf("expected the generated code to contain %q:\n%s", expected, files[1].Content)
+		}
+	}
+}
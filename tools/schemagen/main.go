@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Command schemagen generates Terraform models of the Android Publisher API
+// types from its discovery document. For every type selected in the config,
+// and every type it references, it writes a file with:
+//
+//   - a model struct with a field per property,
+//   - the attribute types of the model,
+//   - the resource schema attributes of the model,
+//   - the data source schema attributes of the model, which are all computed,
+//   - FromAPI and ToAPI methods that convert between the model and the type
+//     of the API client.
+//
+// The discovery document is vendored next to the generator. It must match
+// the version of google.golang.org/api the provider uses, whose client types
+// the generated code converts to and from.
+//
+// Usage:
+//
+//	go run ./schemagen -discovery schemagen/androidpublisher-api.json -config schemagen/config.json -out ../internal/apimodel
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	discovery := flag.String("discovery", "androidpublisher-api.json", "path of the discovery document")
+	configPath := flag.String("config", "config.json", "path of the generator config")
+	out := flag.String("out", ".", "directory of the generated package")
+	flag.Parse()
+
+	if err := run(*discovery, *configPath, *out); err != nil {
+		fmt.Fprintf(os.Stderr, "schemagen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(discovery string, configPath string, out string) error {
+	doc, err := LoadDocument(discovery)
+	if err != nil {
+		return err
+	}
+	config, err := LoadConfig(configPath)
+	if err != nil {
+		return err
+	}
+	models, err := BuildModels(doc, config)
+	if err != nil {
+		return err
+	}
+	files, err := Generate(doc, config, models)
+	if err != nil {
+		return err
+	}
+
+	// Remove the files of types that are no longer generated.
+	stale, err := filepath.Glob(filepath.Join(out, "*_gen.go"))
+	if err != nil {
+		return err
+	}
+	for _, path := range stale {
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+	if err := os.MkdirAll(out, 0o755); err != nil {
+		return err
+	}
+	for _, file := range files {
+		if err := os.WriteFile(filepath.Join(out, file.Name), file.Content, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -13,6 +13,9 @@ import (
 // Generate copyright headers
 //go:generate go run github.com/hashicorp/copywrite headers -d .. --config ../.copywrite.hcl
 
+// Generate the API models, schemas and converters of internal/apimodel.
+//go:generate go run ./schemagen -discovery schemagen/androidpublisher-api.json -config schemagen/config.json -out ../internal/apimodel
+
 // Format Terraform code for use in documentation.
 // If you do not have Terraform installed, you can remove the formatting command, but it is suggested
 // to ensure the documentation is formatted properly.